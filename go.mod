@@ -1,3 +1,3 @@
 module github.com/PavloVM7/go-collections
 
-go 1.21
+go 1.24
@@ -0,0 +1,154 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"iter"
+	"sync"
+)
+
+// ConcurrentSet is a thread safe collection that does not contain duplicate elements.
+// ConcurrentSet is safe for concurrent use by multiple goroutines.
+//   - T - value type
+type ConcurrentSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// Add adds a specified value to the set.
+// Returns true if the value did not exist and was added to the set, otherwise returns false.
+func (cs *ConcurrentSet[T]) Add(value T) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.set.Add(value)
+}
+
+// AddIfAbsent atomically checks whether the value exists in the set and adds it if it does not.
+// Returns true if the value was added to the set.
+func (cs *ConcurrentSet[T]) AddIfAbsent(value T) bool {
+	return cs.Add(value)
+}
+
+// ComputeIfAbsent atomically checks whether the value exists in the set and, if it does not,
+// calls the compute function while holding the lock. The value is added to the set only if
+// the compute function returns true.
+// Returns true if the value was added to the set.
+//   - value - the value to be added
+//   - compute - the function that is called once if the value is absent; it must not access the set
+func (cs *ConcurrentSet[T]) ComputeIfAbsent(value T, compute func(value T) bool) bool {
+	cs.mu.RLock()
+	exists := cs.set.Contains(value)
+	cs.mu.RUnlock()
+	if exists {
+		return false
+	}
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if cs.set.Contains(value) || !compute(value) {
+		return false
+	}
+	return cs.set.Add(value)
+}
+
+// AddAll adds all the specified values to the set.
+// Returns true if this set changed as result of the call.
+func (cs *ConcurrentSet[T]) AddAll(values ...T) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.set.AddAll(values...)
+}
+
+// Contains returns true if the set contains the value
+func (cs *ConcurrentSet[T]) Contains(value T) bool {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.set.Contains(value)
+}
+
+// Remove removes a value from the set.
+// Returns true if this set changed as result of the call.
+func (cs *ConcurrentSet[T]) Remove(value T) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.set.Remove(value)
+}
+
+// Size returns the current size of the set.
+func (cs *ConcurrentSet[T]) Size() int {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.set.Size()
+}
+
+// IsEmpty returns true if the set does not contain any values.
+func (cs *ConcurrentSet[T]) IsEmpty() bool {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.set.IsEmpty()
+}
+
+// TrimToSize trims the capacity of this set instance to be set's current size.
+func (cs *ConcurrentSet[T]) TrimToSize() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.set.TrimToSize()
+}
+
+// Clear clears the set.
+func (cs *ConcurrentSet[T]) Clear() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.set.Clear()
+}
+
+// Capacity returns the capacity value that was set when the set was created.
+func (cs *ConcurrentSet[T]) Capacity() int {
+	return cs.set.Capacity()
+}
+
+// ToSlice return a slice of the set elements.
+func (cs *ConcurrentSet[T]) ToSlice() []T {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.set.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the set elements taken at the moment of the call,
+// so the set can be modified during the iteration.
+func (cs *ConcurrentSet[T]) All() iter.Seq[T] {
+	snapshot := cs.ToSlice()
+	return func(yield func(T) bool) {
+		for _, value := range snapshot {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a snapshot of the set elements, the same as All.
+func (cs *ConcurrentSet[T]) Values() iter.Seq[T] {
+	return cs.All()
+}
+
+// NewConcurrentSet returns a new empty ConcurrentSet instance with capacity equal 0.
+//   - T - value type
+func NewConcurrentSet[T comparable]() *ConcurrentSet[T] {
+	return NewConcurrentSetCapacity[T](0)
+}
+
+// NewConcurrentSetCapacity returns a new empty ConcurrentSet instance with an initial space size (capacity)
+//   - T - value type
+//   - capacity - initial space size
+func NewConcurrentSetCapacity[T comparable](capacity int) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: NewSetCapacity[T](capacity)}
+}
+
+// NewConcurrentSetItems returns a new instance of ConcurrentSet containing specified values.
+// The set capacity is equal to the number of values.
+//   - values ...T - values that the set will contain
+func NewConcurrentSetItems[T comparable](values ...T) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: NewSetItems[T](values...)}
+}
@@ -0,0 +1,99 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSet_AddIfAbsent(t *testing.T) {
+	set := NewConcurrentSetItems[int](1, 2)
+	if set.AddIfAbsent(1) {
+		t.Fatal("dublicate value 1 was added to the set")
+	}
+	if !set.AddIfAbsent(3) {
+		t.Fatal("value 3 was not added to the set")
+	}
+	if actual := sortedSlice(NewSetItems(set.ToSlice()...)); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("AddIfAbsent() got: %v, want: %v", actual, []int{1, 2, 3})
+	}
+}
+
+func TestConcurrentSet_ComputeIfAbsent(t *testing.T) {
+	set := NewConcurrentSetItems[int](1)
+	calls := 0
+	compute := func(value int) bool {
+		calls++
+		return value%2 == 0
+	}
+	if set.ComputeIfAbsent(1, compute) {
+		t.Fatal("existing value was added")
+	}
+	if calls != 0 {
+		t.Fatal("the compute function was called for an existing value")
+	}
+	if set.ComputeIfAbsent(3, compute) {
+		t.Fatal("the value was added although the compute function returned false")
+	}
+	if !set.ComputeIfAbsent(2, compute) {
+		t.Fatal("the value was not added")
+	}
+	if calls != 2 {
+		t.Fatalf("unexpected number of compute calls: %d, want: %d", calls, 2)
+	}
+	if actual := sortedSlice(NewSetItems(set.ToSlice()...)); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Fatalf("ComputeIfAbsent() got: %v, want: %v", actual, []int{1, 2})
+	}
+}
+
+func TestConcurrentSet_All(t *testing.T) {
+	set := NewConcurrentSetItems[int](1, 2, 3)
+	var actual []int
+	for v := range set.All() {
+		set.Remove(v)
+		actual = append(actual, v)
+	}
+	sort.Ints(actual)
+	if !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("All() got: %v, want: %v", actual, []int{1, 2, 3})
+	}
+	if !set.IsEmpty() {
+		t.Fatal("the set isn't empty")
+	}
+}
+
+func TestConcurrentSet_concurrent(t *testing.T) {
+	const goroutines = 8
+	const amount = 1000
+	set := NewConcurrentSet[int]()
+	var computed sync.Map
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				set.Add(i)
+				set.Contains(i)
+				set.ComputeIfAbsent(amount+i, func(value int) bool {
+					if _, loaded := computed.LoadOrStore(value, struct{}{}); loaded {
+						t.Errorf("the value %d was computed twice", value)
+					}
+					return true
+				})
+				if i%100 == 0 {
+					_ = set.ToSlice()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if set.Size() != 2*amount {
+		t.Fatalf("invalid set size, expected: %d, actual: %d", 2*amount, set.Size())
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"iter"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/lists"
+)
+
+// Collection is a group of elements that can be counted, iterated and cleared.
+//   - T - value type
+type Collection[T any] interface {
+	// Size returns the number of elements in the collection.
+	Size() int
+	// IsEmpty returns true if the collection does not contain any elements.
+	IsEmpty() bool
+	// Clear removes all the elements from the collection.
+	Clear()
+	// ToSlice returns a slice containing all elements of the collection.
+	ToSlice() []T
+	// Values returns an iterator over the elements of the collection.
+	// Types whose All method already returns iter.Seq[T], such as the sets, implement Values as the same iterator;
+	// the lists return index-value pairs from All, so Values is the common iteration method of all collections.
+	Values() iter.Seq[T]
+}
+
+// Queue is a first-in-first-out collection: the elements are added to the end
+// and retrieved from the beginning.
+//   - T - value type
+type Queue[T any] interface {
+	Collection[T]
+	// AddLast adds the value to the end of the queue.
+	AddLast(value T)
+	// GetFirst returns the first element of the queue and true if it exists.
+	GetFirst() (T, bool)
+	// RemoveFirst removes the first element of the queue and returns its value and true if it exists.
+	RemoveFirst() (T, bool)
+}
+
+// Stack is a last-in-first-out collection: the elements are added to and retrieved from the end.
+//   - T - value type
+type Stack[T any] interface {
+	Collection[T]
+	// AddLast pushes the value onto the stack.
+	AddLast(value T)
+	// GetLast returns the top element of the stack and true if it exists.
+	GetLast() (T, bool)
+	// RemoveLast removes the top element of the stack and returns its value and true if it exists.
+	RemoveLast() (T, bool)
+}
+
+// Deque is a double-ended queue that supports adding and retrieving elements at both ends,
+// so it can be used both as a Queue and as a Stack.
+//   - T - value type
+type Deque[T any] interface {
+	Queue[T]
+	Stack[T]
+	// AddFirst adds the value to the beginning of the deque.
+	AddFirst(value T)
+}
+
+// List is an ordered collection that provides access to its elements by index.
+// The methods that take an index return lists.ErrIndexOutOfRange if the index is out of range.
+//   - T - value type
+type List[T any] interface {
+	Deque[T]
+	// Get returns the element at the specified position.
+	Get(index int) (T, error)
+	// Set replaces the element at the specified position and returns the previous value.
+	Set(index int, value T) (T, error)
+	// Insert inserts the value at the specified position, shifting the subsequent elements.
+	Insert(index int, value T) error
+	// InsertAll inserts the values at the specified position in the order they are given.
+	InsertAll(index int, values ...T) error
+	// Remove removes the element at the specified position and returns its value.
+	Remove(index int) (T, error)
+	// RemoveFirstOccurrence removes the first element that matches the condition
+	// and returns its value and index, or -1 if there is no such element.
+	RemoveFirstOccurrence(needToRemove func(value T) bool) (T, int)
+	// RemoveLastOccurrence removes the last element that matches the condition
+	// and returns its value and index, or -1 if there is no such element.
+	RemoveLastOccurrence(needToRemove func(value T) bool) (T, int)
+	// RemoveAll removes all the elements that match the condition and returns the number of removed elements.
+	RemoveAll(needRemove func(value T) bool) int
+	// ToArray returns an array containing all elements of the list in the proper sequence.
+	ToArray() []T
+	// Sort sorts the list according to the order specified by the less function in O(n*log(n)) time.
+	// The sort is not guaranteed to be stable.
+	Sort(less func(item1, item2 T) bool)
+	// SortStable sorts the list according to the order specified by the less function
+	// keeping the original order of equal elements.
+	SortStable(less func(item1, item2 T) bool)
+}
+
+var (
+	_ Collection[int] = (*Set[int])(nil)
+	_ Collection[int] = (*ConcurrentSet[int])(nil)
+	_ Collection[int] = (*ShardedSet[int])(nil)
+	_ Collection[int] = (*OrderedSet[int])(nil)
+	_ Collection[int] = (*SortedSet[int])(nil)
+	_ List[int]       = (*lists.LinkedList[int])(nil)
+	_ List[int]       = (*lists.ArrayList[int])(nil)
+	_ Deque[int]      = (*lists.ArrayDeque[int])(nil)
+)
@@ -0,0 +1,103 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/lists"
+)
+
+func collectionSum(c Collection[int]) int {
+	sum := 0
+	for v := range c.Values() {
+		sum += v
+	}
+	return sum
+}
+
+func TestCollection(t *testing.T) {
+	set := NewSetItems(1, 2, 3)
+	list := lists.NewLinkedList[int]()
+	list.AddLast(1)
+	list.AddLast(2)
+	list.AddLast(3)
+	type testCase struct {
+		name       string
+		collection Collection[int]
+	}
+	tests := []testCase{
+		{"Set", &set},
+		{"ConcurrentSet", NewConcurrentSetItems(1, 2, 3)},
+		{"OrderedSet", NewOrderedSetItems(1, 2, 3)},
+		{"SortedSet", NewSortedSetItems(func(a, b int) int { return a - b }, 1, 2, 3)},
+		{"LinkedList", list},
+		{"ArrayList", lists.NewArrayListItems(1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.collection.Size() != 3 || tt.collection.IsEmpty() {
+				t.Fatalf("invalid size: %d", tt.collection.Size())
+			}
+			if sum := collectionSum(tt.collection); sum != 6 {
+				t.Fatalf("Values() sum got: %d, want: %d", sum, 6)
+			}
+			values := tt.collection.ToSlice()
+			sort.Ints(values)
+			if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+				t.Fatalf("ToSlice() got: %v, want: %v", values, []int{1, 2, 3})
+			}
+			tt.collection.Clear()
+			if !tt.collection.IsEmpty() || len(slices.Collect(tt.collection.Values())) != 0 {
+				t.Fatal("the collection was not cleared")
+			}
+		})
+	}
+}
+
+func TestQueueStack(t *testing.T) {
+	var deque Deque[string] = lists.NewLinkedList[string]()
+	var queue Queue[string] = deque
+	var stack Stack[string] = deque
+	queue.AddLast("a")
+	queue.AddLast("b")
+	deque.AddFirst("z")
+	if v, ok := queue.RemoveFirst(); !ok || v != "z" {
+		t.Fatalf("RemoveFirst() got: %s, %t, want: %s, true", v, ok, "z")
+	}
+	if v, ok := stack.RemoveLast(); !ok || v != "b" {
+		t.Fatalf("RemoveLast() got: %s, %t, want: %s, true", v, ok, "b")
+	}
+	if v, ok := stack.GetLast(); !ok || v != "a" {
+		t.Fatalf("GetLast() got: %s, %t, want: %s, true", v, ok, "a")
+	}
+}
+
+func TestList_Sort(t *testing.T) {
+	type testCase struct {
+		name string
+		list List[int]
+	}
+	tests := []testCase{
+		{"LinkedList", lists.NewLinkedList[int]()},
+		{"ArrayList", lists.NewArrayList[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = tt.list.InsertAll(0, 3, 1, 2)
+			tt.list.Sort(func(a, b int) bool { return a < b })
+			if actual := tt.list.ToArray(); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+				t.Fatalf("Sort() got: %v, want: %v", actual, []int{1, 2, 3})
+			}
+			tt.list.SortStable(func(a, b int) bool { return a > b })
+			if actual := tt.list.ToArray(); !reflect.DeepEqual(actual, []int{3, 2, 1}) {
+				t.Fatalf("SortStable() got: %v, want: %v", actual, []int{3, 2, 1})
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package codec contains the binary format shared by the collections.
+//
+// The format is a version byte, followed by the number of elements as an unsigned varint,
+// followed by the elements encoded one by one as a single encoding/gob stream.
+package codec
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Version is the current version of the binary format
+const Version byte = 1
+
+// maxPrealloc limits the number of elements allocated in advance, so corrupted data can't exhaust memory
+const maxPrealloc = 1 << 20
+
+var (
+	// ErrUnsupportedVersion error: 'unsupported binary format version'
+	ErrUnsupportedVersion = errors.New("unsupported binary format version")
+)
+
+// Encode writes the size and the values to the writer in the binary format.
+//   - size - the number of values
+//   - values - the values to be written
+func Encode[T any](w io.Writer, size int, values iter.Seq[T]) error {
+	header := make([]byte, 1, 1+binary.MaxVarintLen64)
+	header[0] = Version
+	header = binary.AppendUvarint(header, uint64(size))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	enc := gob.NewEncoder(w)
+	for value := range values {
+		if err := enc.Encode(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads values in the binary format from the reader, passing each value to the add function
+// as soon as it is decoded, so the values are not buffered.
+// If the reader does not implement io.ByteReader, it is wrapped in a bufio.Reader and may be read ahead.
+//   - start - the function that is called with the number of values to be decoded
+//     (limited to avoid huge allocations on corrupted data) before any value is decoded
+//   - add - the function that is called for each decoded value
+func Decode[T any](r io.Reader, start func(size int), add func(value T)) error {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		buffered := bufio.NewReader(r)
+		r, br = buffered, buffered
+	}
+	version, err := br.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	size, err := binary.ReadUvarint(br)
+	if err != nil {
+		return err
+	}
+	start(int(min(size, maxPrealloc)))
+	dec := gob.NewDecoder(r)
+	for i := uint64(0); i < size; i++ {
+		var value T
+		if err = dec.Decode(&value); err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		add(value)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type codecTestStruct struct {
+	Name  string
+	Value int
+}
+
+func TestEncode_Decode(t *testing.T) {
+	values := []codecTestStruct{{"one", 1}, {}, {"", 0}, {"three", 3}}
+	var buf bytes.Buffer
+	if err := Encode(&buf, len(values), slices.Values(values)); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if buf.Bytes()[0] != Version {
+		t.Fatalf("unexpected version: %d, want: %d", buf.Bytes()[0], Version)
+	}
+	var size int
+	var actual []codecTestStruct
+	err := Decode(io.MultiReader(&buf), func(s int) { size = s }, func(value codecTestStruct) {
+		actual = append(actual, value)
+	})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if size != len(values) {
+		t.Fatalf("unexpected size: %d, want: %d", size, len(values))
+	}
+	if !reflect.DeepEqual(actual, values) {
+		t.Fatalf("Decode() got: %v, want: %v", actual, values)
+	}
+}
+
+func TestDecode_fail(t *testing.T) {
+	var valid bytes.Buffer
+	_ = Encode(&valid, 3, slices.Values([]int{1, 2, 3}))
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"empty", nil, io.EOF},
+		{"version", []byte{Version + 1, 0}, ErrUnsupportedVersion},
+		{"no size", []byte{Version}, io.EOF},
+		{"truncated", valid.Bytes()[:valid.Len()-2], io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Decode(bytes.NewReader(tt.data), func(int) {}, func(int) {})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error: '%v', got: '%v'", tt.wantErr, err)
+			}
+		})
+	}
+}
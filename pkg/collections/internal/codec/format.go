@@ -0,0 +1,44 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GoSyntax returns a Go-syntax representation of a constructor call with the values as arguments,
+// e.g. 'lists.NewLinkedListItems[int](1, 2, 3)'.
+//   - constructor - the qualified name of the constructor function
+func GoSyntax[T any](constructor string, values []T) string {
+	var sb strings.Builder
+	var zero T
+	sb.WriteString(constructor)
+	fmt.Fprintf(&sb, "[%T](", zero)
+	for i, value := range values {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%#v", value)
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// Format implements the fmt.Formatter behaviour shared by the collections:
+//   - %#v - Go-syntax representation (see GoSyntax)
+//   - %+v - the details (e.g. size and capacity) followed by the elements
+//   - other verbs and flags are applied to the elements like they are applied to a slice
+func Format[T any](f fmt.State, verb rune, constructor, details string, values []T) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = io.WriteString(f, GoSyntax(constructor, values))
+	case verb == 'v' && f.Flag('+'):
+		_, _ = fmt.Fprintf(f, "{%s, elements: %+v}", details, values)
+	default:
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), values)
+	}
+}
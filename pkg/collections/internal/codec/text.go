@@ -0,0 +1,145 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+var (
+	// ErrUnsupportedTextType error: 'element type does not support text encoding'
+	ErrUnsupportedTextType = errors.New("element type does not support text encoding")
+)
+
+// MarshalText encodes the values as a single comma-separated (CSV) record.
+// The values must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func MarshalText[T any](values iter.Seq[T]) ([]byte, error) {
+	record := make([]string, 0)
+	for value := range values {
+		text, err := ValueToText(value)
+		if err != nil {
+			return nil, err
+		}
+		record = append(record, text)
+	}
+	if len(record) == 0 {
+		return []byte{}, nil
+	}
+	if len(record) == 1 && record[0] == "" {
+		return []byte(`""`), nil
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// UnmarshalText decodes the values encoded by MarshalText, passing each value to the add function.
+// The values must implement encoding.TextUnmarshaler or be of a string, boolean, integer or floating-point kind.
+func UnmarshalText[T any](data []byte, add func(value T)) error {
+	if len(data) == 0 {
+		return nil
+	}
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	record, err := r.Read()
+	if err != nil {
+		return err
+	}
+	if _, err = r.Read(); err == nil {
+		return errors.New("unexpected multiple records")
+	}
+	for _, text := range record {
+		var value T
+		if err = TextToValue(text, &value); err != nil {
+			return err
+		}
+		add(value)
+	}
+	return nil
+}
+
+// ValueToText returns the text representation of the value.
+// The value must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func ValueToText(value any) (string, error) {
+	if tm, ok := value.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return "", ErrUnsupportedTextType
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedTextType, rv.Type())
+	}
+}
+
+// TextToValue parses the text representation of a value created by ValueToText.
+//
+//revive:disable:cyclomatic
+func TextToValue[T any](text string, value *T) error {
+	if tu, ok := any(value).(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(text))
+	}
+	rv := reflect.ValueOf(value).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedTextType, rv.Type())
+	}
+	return nil
+}
+
+//revive:enable:cyclomatic
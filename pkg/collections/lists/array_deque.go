@@ -0,0 +1,242 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import "iter"
+
+const minArrayDequeCapacity = 8
+
+// ArrayDeque is a double-ended queue backed by a growable circular buffer.
+// AddFirst, AddLast, RemoveFirst and RemoveLast take amortized O(1) time, Get and Set take O(1) time.
+// Unlike LinkedList, ArrayDeque does not allocate memory for each element.
+// If the deque was created by NewArrayDequeShrinking, the buffer is halved when the deque becomes
+// less than a quarter full, but never below the initial capacity.
+// The zero value of ArrayDeque is an empty deque ready to use.
+type ArrayDeque[T any] struct {
+	values   []T
+	head     int
+	size     int
+	capacity int
+	shrink   bool
+}
+
+// index returns the position in the buffer of the element with the specified index.
+func (dq *ArrayDeque[T]) index(index int) int {
+	index += dq.head
+	if index >= len(dq.values) {
+		index -= len(dq.values)
+	}
+	return index
+}
+
+// resize moves the elements to a new buffer of the specified length.
+func (dq *ArrayDeque[T]) resize(length int) {
+	values := make([]T, length)
+	n := copy(values, dq.values[dq.head:min(dq.head+dq.size, len(dq.values))])
+	copy(values[n:], dq.values[:dq.size-n])
+	dq.values = values
+	dq.head = 0
+}
+
+func (dq *ArrayDeque[T]) grow() {
+	if dq.size == len(dq.values) {
+		dq.resize(max(2*len(dq.values), dq.capacity, minArrayDequeCapacity))
+	}
+}
+
+func (dq *ArrayDeque[T]) shrinkIfNeeded() {
+	if dq.shrink && dq.size < len(dq.values)/4 && len(dq.values)/2 >= max(dq.capacity, minArrayDequeCapacity) {
+		dq.resize(len(dq.values) / 2)
+	}
+}
+
+// AddLast appends specified element to the end of this deque.
+//   - value - the value to be appended
+func (dq *ArrayDeque[T]) AddLast(value T) {
+	dq.grow()
+	dq.values[dq.index(dq.size)] = value
+	dq.size++
+}
+
+// AddFirst inserts specified element to the beginning this deque.
+//   - value - the value to be inserted
+func (dq *ArrayDeque[T]) AddFirst(value T) {
+	dq.grow()
+	dq.head--
+	if dq.head < 0 {
+		dq.head += len(dq.values)
+	}
+	dq.values[dq.head] = value
+	dq.size++
+}
+
+// GetFirst returns the first element of this deque and true if it exists.
+// If the deque is empty, this method returns a default value of type T and false.
+func (dq *ArrayDeque[T]) GetFirst() (T, bool) {
+	if dq.size > 0 {
+		return dq.values[dq.head], true
+	}
+	var res T
+	return res, false
+}
+
+// GetLast returns the last element of this deque and true if it exists.
+// If the deque is empty, this method returns a default value of type T and false.
+func (dq *ArrayDeque[T]) GetLast() (T, bool) {
+	if dq.size > 0 {
+		return dq.values[dq.index(dq.size-1)], true
+	}
+	var res T
+	return res, false
+}
+
+// Get returns an item at the specified position in this deque
+// or a default value of type T and an error if the index is out of range.
+func (dq *ArrayDeque[T]) Get(index int) (T, error) {
+	if index < 0 || index >= dq.size {
+		var res T
+		return res, ErrIndexOutOfRange
+	}
+	return dq.values[dq.index(index)], nil
+}
+
+// Set replaces the element at the specified position in this deque with the specified value.
+// Returns the value previously at the specified position
+// or a default value of type T and an error if the index is out of range.
+//   - index - index of the element to replace
+//   - value - the value to be stored at the specified position
+func (dq *ArrayDeque[T]) Set(index int, value T) (T, error) {
+	var old T
+	if index < 0 || index >= dq.size {
+		return old, ErrIndexOutOfRange
+	}
+	i := dq.index(index)
+	old, dq.values[i] = dq.values[i], value
+	return old, nil
+}
+
+// RemoveFirst removes the first item from this deque and returns its value and true if it exists.
+// If the deque is empty, a default value of type T and false is returned.
+func (dq *ArrayDeque[T]) RemoveFirst() (T, bool) {
+	var res T
+	if dq.size == 0 {
+		return res, false
+	}
+	res, dq.values[dq.head] = dq.values[dq.head], res
+	dq.head = dq.index(1)
+	dq.size--
+	dq.shrinkIfNeeded()
+	return res, true
+}
+
+// RemoveLast removes the last item from this deque and returns its value and true if it exists.
+// If the deque is empty, a default value of type T and false is returned.
+func (dq *ArrayDeque[T]) RemoveLast() (T, bool) {
+	var res T
+	if dq.size == 0 {
+		return res, false
+	}
+	i := dq.index(dq.size - 1)
+	res, dq.values[i] = dq.values[i], res
+	dq.size--
+	dq.shrinkIfNeeded()
+	return res, true
+}
+
+// ToArray returns an array containing all elements of this deque in the proper sequence
+// (from the first to the last element).
+func (dq *ArrayDeque[T]) ToArray() []T {
+	result := make([]T, dq.size)
+	n := copy(result, dq.values[dq.head:min(dq.head+dq.size, len(dq.values))])
+	copy(result[n:], dq.values[:dq.size-n])
+	return result
+}
+
+// ToSlice returns a slice containing all elements of this deque in the proper sequence
+// (from the first to the last element). It is the same as ToArray and is provided to match the other collections.
+func (dq *ArrayDeque[T]) ToSlice() []T {
+	return dq.ToArray()
+}
+
+// All returns an iterator over index-value pairs of this deque in the proper sequence
+// (from the first to the last element).
+func (dq *ArrayDeque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < dq.size; i++ {
+			if !yield(i, dq.values[dq.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this deque in the proper sequence
+// (from the first to the last element).
+func (dq *ArrayDeque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < dq.size; i++ {
+			if !yield(dq.values[dq.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of this deque in reverse order
+// (from the last to the first element).
+func (dq *ArrayDeque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := dq.size - 1; i >= 0; i-- {
+			if !yield(i, dq.values[dq.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear clears this deque. The deque keeps the capacity that was set when it was created.
+func (dq *ArrayDeque[T]) Clear() {
+	dq.values = make([]T, dq.capacity)
+	dq.head = 0
+	dq.size = 0
+}
+
+// Size returns the number of elements in this deque
+func (dq *ArrayDeque[T]) Size() int {
+	return dq.size
+}
+
+// IsEmpty returns true if this deque does not contain any elements
+func (dq *ArrayDeque[T]) IsEmpty() bool {
+	return dq.size == 0
+}
+
+// Capacity returns the number of elements this deque can hold without allocating more memory.
+func (dq *ArrayDeque[T]) Capacity() int {
+	return len(dq.values)
+}
+
+// NewArrayDeque constructs an empty deque
+func NewArrayDeque[T any]() *ArrayDeque[T] {
+	return NewArrayDequeCapacity[T](0)
+}
+
+// NewArrayDequeCapacity constructs an empty deque with the specified initial capacity.
+//   - capacity - initial space size
+func NewArrayDequeCapacity[T any](capacity int) *ArrayDeque[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &ArrayDeque[T]{values: make([]T, capacity), capacity: capacity}
+}
+
+// NewArrayDequeShrinking constructs an empty deque with the specified initial capacity
+// that releases memory when it becomes less than a quarter full.
+//   - capacity - initial space size, the buffer never shrinks below it
+func NewArrayDequeShrinking[T any](capacity int) *ArrayDeque[T] {
+	result := NewArrayDequeCapacity[T](capacity)
+	result.shrink = true
+	return result
+}
@@ -0,0 +1,80 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"testing"
+)
+
+type benchmarkDeque interface {
+	AddLast(value int)
+	AddFirst(value int)
+	RemoveFirst() (int, bool)
+	RemoveLast() (int, bool)
+	Get(index int) (int, error)
+}
+
+var benchmarkDeques = []struct {
+	name  string
+	deque func() benchmarkDeque
+}{
+	{"ArrayDeque", func() benchmarkDeque { return NewArrayDeque[int]() }},
+	{"LinkedList", func() benchmarkDeque { return NewLinkedList[int]() }},
+}
+
+func BenchmarkDeque_queue(b *testing.B) {
+	const size = 1000
+	for _, bm := range benchmarkDeques {
+		b.Run(fmt.Sprintf("%s %d", bm.name, size), func(b *testing.B) {
+			b.ReportAllocs()
+			dq := bm.deque()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					dq.AddLast(j)
+				}
+				for j := 0; j < size; j++ {
+					dq.RemoveFirst()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDeque_stack(b *testing.B) {
+	const size = 1000
+	for _, bm := range benchmarkDeques {
+		b.Run(fmt.Sprintf("%s %d", bm.name, size), func(b *testing.B) {
+			b.ReportAllocs()
+			dq := bm.deque()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					dq.AddFirst(j)
+				}
+				for j := 0; j < size; j++ {
+					dq.RemoveFirst()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDeque_Get(b *testing.B) {
+	const size = 1000
+	for _, bm := range benchmarkDeques {
+		b.Run(fmt.Sprintf("%s %d", bm.name, size), func(b *testing.B) {
+			dq := bm.deque()
+			for j := 0; j < size; j++ {
+				dq.AddLast(j)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if v, _ := dq.Get(i % size); v != i%size {
+					b.Fatalf("Get() got: %d, want: %d", v, i%size)
+				}
+			}
+		})
+	}
+}
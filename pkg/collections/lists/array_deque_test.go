@@ -0,0 +1,175 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewArrayDeque(t *testing.T) {
+	dq := NewArrayDeque[int]()
+	if !dq.IsEmpty() || dq.Size() != 0 || dq.Capacity() != 0 {
+		t.Fatalf("invalid new deque: size: %d, capacity: %d", dq.Size(), dq.Capacity())
+	}
+	if dq = NewArrayDequeCapacity[int](16); dq.Capacity() != 16 {
+		t.Fatalf("invalid capacity, expected: %d, actual: %d", 16, dq.Capacity())
+	}
+	if _, ok := dq.GetFirst(); ok {
+		t.Fatal("GetFirst() returned an element of an empty deque")
+	}
+	if _, ok := dq.GetLast(); ok {
+		t.Fatal("GetLast() returned an element of an empty deque")
+	}
+	if _, ok := dq.RemoveFirst(); ok {
+		t.Fatal("RemoveFirst() removed an element of an empty deque")
+	}
+	if _, ok := dq.RemoveLast(); ok {
+		t.Fatal("RemoveLast() removed an element of an empty deque")
+	}
+	var zero ArrayDeque[string]
+	zero.AddFirst("b")
+	zero.AddFirst("a")
+	zero.AddLast("c")
+	if actual := zero.ToArray(); !reflect.DeepEqual(actual, []string{"a", "b", "c"}) {
+		t.Fatalf("zero value deque got: %v, want: %v", actual, []string{"a", "b", "c"})
+	}
+}
+
+func TestArrayDeque_wrap(t *testing.T) {
+	dq := NewArrayDequeCapacity[int](8)
+	for i := 0; i < 6; i++ {
+		dq.AddLast(i)
+	}
+	for i := 0; i < 4; i++ {
+		dq.RemoveFirst()
+	}
+	for i := 6; i < 12; i++ {
+		dq.AddLast(i)
+	}
+	if dq.Capacity() != 8 {
+		t.Fatalf("the buffer was reallocated, capacity: %d", dq.Capacity())
+	}
+	want := []int{4, 5, 6, 7, 8, 9, 10, 11}
+	if actual := dq.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("ToArray() got: %v, want: %v", actual, want)
+	}
+	for i, v := range want {
+		if actual, err := dq.Get(i); err != nil || actual != v {
+			t.Fatalf("Get(%d) got: %d, %v, want: %d", i, actual, err, v)
+		}
+	}
+	dq.AddFirst(3)
+	want = append([]int{3}, want...)
+	if actual := dq.ToArray(); !reflect.DeepEqual(actual, want) || dq.Capacity() != 16 {
+		t.Fatalf("ToArray() got: %v, capacity: %d, want: %v", actual, dq.Capacity(), want)
+	}
+	if last, _ := dq.GetLast(); last != 11 {
+		t.Fatalf("GetLast() got: %d, want: %d", last, 11)
+	}
+	if actual := slices.Collect(dq.Values()); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Values() got: %v, want: %v", actual, want)
+	}
+	var backward []int
+	for i, v := range dq.Backward() {
+		if want[i] != v {
+			t.Fatalf("Backward() index: %d, value: %d, want: %d", i, v, want[i])
+		}
+		backward = append(backward, v)
+	}
+	if len(backward) != len(want) {
+		t.Fatalf("Backward() got: %v", backward)
+	}
+}
+
+func TestArrayDeque_index_fail(t *testing.T) {
+	dq := NewArrayDeque[int]()
+	dq.AddLast(1)
+	for _, index := range []int{-1, 1} {
+		if _, err := dq.Get(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Get(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+		if _, err := dq.Set(index, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Set(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+	}
+	if old, err := dq.Set(0, 5); err != nil || old != 1 {
+		t.Fatalf("Set() got: %d, %v, want: %d", old, err, 1)
+	}
+}
+
+func TestArrayDeque_LinkedList(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	dq := NewArrayDequeShrinking[int](0)
+	list := NewLinkedList[int]()
+	for i := 0; i < 5000; i++ {
+		// the removal probability grows with time, so the deque both grows and shrinks
+		switch op := rnd.Intn(4 + i/1000); op {
+		case 0:
+			dq.AddFirst(i)
+			list.AddFirst(i)
+		case 1:
+			dq.AddLast(i)
+			list.AddLast(i)
+		case 2, 4:
+			vD, okD := dq.RemoveFirst()
+			vL, okL := list.RemoveFirst()
+			if vD != vL || okD != okL {
+				t.Fatalf("RemoveFirst() got: %d, %t, want: %d, %t", vD, okD, vL, okL)
+			}
+		default:
+			vD, okD := dq.RemoveLast()
+			vL, okL := list.RemoveLast()
+			if vD != vL || okD != okL {
+				t.Fatalf("RemoveLast() got: %d, %t, want: %d, %t", vD, okD, vL, okL)
+			}
+		}
+		if dq.Size() != list.Size() {
+			t.Fatalf("step %d: size: %d, want: %d", i, dq.Size(), list.Size())
+		}
+		if dq.Capacity() > minArrayDequeCapacity && dq.Size() < dq.Capacity()/4 {
+			t.Fatalf("step %d: the deque was not shrunk: size: %d, capacity: %d", i, dq.Size(), dq.Capacity())
+		}
+	}
+	if !reflect.DeepEqual(dq.ToArray(), list.ToArray()) {
+		t.Fatalf("ArrayDeque: %v, LinkedList: %v", dq.ToArray(), list.ToArray())
+	}
+}
+
+func TestArrayDeque_shrink(t *testing.T) {
+	type testCase struct {
+		name         string
+		deque        *ArrayDeque[int]
+		wantCapacity int
+	}
+	tests := []testCase{
+		{"not shrinking", NewArrayDequeCapacity[int](16), 1024},
+		{"shrinking", NewArrayDequeShrinking[int](16), 16},
+		{"shrinking with large capacity", NewArrayDequeShrinking[int](2048), 2048},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				tt.deque.AddLast(i)
+			}
+			for i := 0; i < 999; i++ {
+				tt.deque.RemoveLast()
+			}
+			if tt.deque.Capacity() != tt.wantCapacity {
+				t.Fatalf("Capacity() got: %d, want: %d", tt.deque.Capacity(), tt.wantCapacity)
+			}
+			if first, _ := tt.deque.GetFirst(); first != 0 || tt.deque.Size() != 1 {
+				t.Fatalf("the deque was corrupted: %v", tt.deque.ToArray())
+			}
+			tt.deque.Clear()
+			if !tt.deque.IsEmpty() {
+				t.Fatal("the deque was not cleared")
+			}
+		})
+	}
+}
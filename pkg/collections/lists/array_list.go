@@ -0,0 +1,281 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"iter"
+	"slices"
+)
+
+// ArrayList is an implementation of a list backed by a slice.
+// Get and Set take O(1) time, AddLast and RemoveLast take amortized O(1) time,
+// insertion and removal at other positions take O(n) time.
+// The zero value of ArrayList is an empty list ready to use.
+type ArrayList[T any] struct {
+	values   []T
+	capacity int
+}
+
+// AddLast appends specified element to the end of this list.
+//   - value - the value to be appended
+func (list *ArrayList[T]) AddLast(value T) {
+	list.values = append(list.values, value)
+}
+
+// AddFirst inserts specified element to the beginning this list.
+//   - value - the value to be inserted
+func (list *ArrayList[T]) AddFirst(value T) {
+	list.values = slices.Insert(list.values, 0, value)
+}
+
+// GetFirst returns the first element of this list and true if it exists.
+// If the list is empty, this method returns a default value of type T and false.
+func (list *ArrayList[T]) GetFirst() (T, bool) {
+	if len(list.values) > 0 {
+		return list.values[0], true
+	}
+	var res T
+	return res, false
+}
+
+// GetLast returns the last element of this list and true if it exists.
+// If the list is empty, this method returns a default value of type T and false.
+func (list *ArrayList[T]) GetLast() (T, bool) {
+	if len(list.values) > 0 {
+		return list.values[len(list.values)-1], true
+	}
+	var res T
+	return res, false
+}
+
+// Get returns an item at the specified position in this list
+// or a default value of type T and an error if the index is out of range.
+func (list *ArrayList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= len(list.values) {
+		var res T
+		return res, ErrIndexOutOfRange
+	}
+	return list.values[index], nil
+}
+
+// Set replaces the element at the specified position in this list with the specified value.
+// Returns the value previously at the specified position
+// or a default value of type T and an error if the index is out of range.
+//   - index - index of the element to replace
+//   - value - the value to be stored at the specified position
+func (list *ArrayList[T]) Set(index int, value T) (T, error) {
+	var old T
+	if index < 0 || index >= len(list.values) {
+		return old, ErrIndexOutOfRange
+	}
+	old, list.values[index] = list.values[index], value
+	return old, nil
+}
+
+// Insert inserts the specified value at the specified position in this list.
+// Shifts the element currently at that position (if any) and any subsequent elements to the right.
+// Returns an error if the index is out of range (index < 0 || index > Size()).
+//   - index - index at which the specified value is to be inserted
+//   - value - the value to be inserted
+func (list *ArrayList[T]) Insert(index int, value T) error {
+	return list.InsertAll(index, value)
+}
+
+// InsertAll inserts all the specified values at the specified position in this list in the order they are given.
+// Shifts the element currently at that position (if any) and any subsequent elements to the right.
+// Returns an error if the index is out of range (index < 0 || index > Size()).
+//   - index - index at which to insert the first of the specified values
+//   - values - the values to be inserted
+func (list *ArrayList[T]) InsertAll(index int, values ...T) error {
+	if index < 0 || index > len(list.values) {
+		return ErrIndexOutOfRange
+	}
+	list.values = slices.Insert(list.values, index, values...)
+	return nil
+}
+
+// RemoveFirst removes the first item from this list and returns its value and true if it exists.
+// If the list is empty, a default value of type T and false is returned.
+func (list *ArrayList[T]) RemoveFirst() (T, bool) {
+	res, err := list.Remove(0)
+	return res, err == nil
+}
+
+// RemoveLast removes the last item from this list and returns its value and true if it exists.
+// If the list is empty, a default value of type T and false is returned.
+func (list *ArrayList[T]) RemoveLast() (T, bool) {
+	res, err := list.Remove(len(list.values) - 1)
+	return res, err == nil
+}
+
+// Remove removes the element at the specified position in this list and returns its value
+// or a default value of type T and an error if the index is out of range.
+func (list *ArrayList[T]) Remove(index int) (T, error) {
+	res, err := list.Get(index)
+	if err == nil {
+		list.values = slices.Delete(list.values, index, index+1)
+	}
+	return res, err
+}
+
+// RemoveFirstOccurrence removes from the list the first occurrence of an element that satisfies the condition
+// specified by the function (when traversing the list from head to tail).
+// Returns the value and index of the removed element, or a default value of type T and -1 if no element was removed.
+//   - needToRemove - a function that is applied to each element to determine if it should be deleted
+func (list *ArrayList[T]) RemoveFirstOccurrence(needToRemove func(value T) bool) (T, int) {
+	index := slices.IndexFunc(list.values, needToRemove)
+	res, _ := list.Remove(index)
+	return res, index
+}
+
+// RemoveLastOccurrence removes from the list the last occurrence of an element that satisfies the condition
+// specified by the needToRemove function (when traversing the list from tail to head).
+// Returns the value and index of the removed element, or a default value of type T and -1 if no element was removed.
+//   - needToRemove - a function that is applied to each element to determine if it should be deleted
+func (list *ArrayList[T]) RemoveLastOccurrence(needToRemove func(value T) bool) (T, int) {
+	for index := len(list.values) - 1; index >= 0; index-- {
+		if needToRemove(list.values[index]) {
+			res, _ := list.Remove(index)
+			return res, index
+		}
+	}
+	var res T
+	return res, -1
+}
+
+// RemoveAll removes from the list all elements that satisfy the condition specified by the needToRemove function.
+// Returns the number of elements removed
+//   - needToRemove - a function that is applied to each element to determine if it should be deleted
+func (list *ArrayList[T]) RemoveAll(needRemove func(value T) bool) int {
+	size := len(list.values)
+	list.values = slices.DeleteFunc(list.values, needRemove)
+	return size - len(list.values)
+}
+
+// Sort sorts the list according to the order specified by the less function.
+// The sort is not guaranteed to be stable.
+//   - less - the function used to compare list elements
+func (list *ArrayList[T]) Sort(less func(item1, item2 T) bool) {
+	slices.SortFunc(list.values, lessToCompare(less))
+}
+
+// SortStable sorts the list according to the order specified by the less function
+// keeping the original order of equal elements.
+//   - less - the function used to compare list elements
+func (list *ArrayList[T]) SortStable(less func(item1, item2 T) bool) {
+	slices.SortStableFunc(list.values, lessToCompare(less))
+}
+
+func lessToCompare[T any](less func(item1, item2 T) bool) func(item1, item2 T) int {
+	return func(item1, item2 T) int {
+		switch {
+		case less(item1, item2):
+			return -1
+		case less(item2, item1):
+			return 1
+		}
+		return 0
+	}
+}
+
+// ToArray returns an array containing all elements of this list in the proper sequence
+// (from the first to the last element).
+func (list *ArrayList[T]) ToArray() []T {
+	result := make([]T, len(list.values))
+	copy(result, list.values)
+	return result
+}
+
+// ToSlice returns a slice containing all elements of this list in the proper sequence
+// (from the first to the last element). It is the same as ToArray and is provided to match the other collections.
+func (list *ArrayList[T]) ToSlice() []T {
+	return list.ToArray()
+}
+
+// All returns an iterator over index-value pairs of this list in the proper sequence
+// (from the first to the last element).
+func (list *ArrayList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(list.values); i++ {
+			if !yield(i, list.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this list in the proper sequence
+// (from the first to the last element).
+func (list *ArrayList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(list.values); i++ {
+			if !yield(list.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of this list in reverse order
+// (from the last to the first element).
+func (list *ArrayList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(list.values) - 1; i >= 0; i-- {
+			if !yield(i, list.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear clears this list. The list keeps the capacity that was set when it was created.
+func (list *ArrayList[T]) Clear() {
+	list.values = make([]T, 0, list.capacity)
+}
+
+// Size returns the number of elements in this list
+func (list *ArrayList[T]) Size() int {
+	return len(list.values)
+}
+
+// IsEmpty returns true if this list does not contain any elements
+func (list *ArrayList[T]) IsEmpty() bool {
+	return len(list.values) == 0
+}
+
+// Capacity returns the capacity value that was set when the list was created.
+// It does not change when the list grows or is trimmed, as the capacity of Set does.
+func (list *ArrayList[T]) Capacity() int {
+	return list.capacity
+}
+
+// TrimToSize trims the capacity of this list instance to be the list's current size.
+// An application can use this operation to minimize the storage of a list instance.
+func (list *ArrayList[T]) TrimToSize() {
+	list.values = list.ToArray()
+}
+
+// NewArrayList constructs an empty list
+func NewArrayList[T any]() *ArrayList[T] {
+	return NewArrayListCapacity[T](0)
+}
+
+// NewArrayListCapacity constructs an empty list with the specified initial capacity.
+//   - capacity - initial space size
+func NewArrayListCapacity[T any](capacity int) *ArrayList[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &ArrayList[T]{values: make([]T, 0, capacity), capacity: capacity}
+}
+
+// NewArrayListItems constructs a list containing the specified values in the order they are given.
+// The list capacity is equal to the number of values.
+//   - values ...T - values that the list will contain
+func NewArrayListItems[T any](values ...T) *ArrayList[T] {
+	result := NewArrayListCapacity[T](len(values))
+	result.values = append(result.values, values...)
+	return result
+}
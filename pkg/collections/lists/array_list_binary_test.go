@@ -0,0 +1,75 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestArrayList_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		list *ArrayList[binaryTestStruct]
+	}{
+		{"empty", NewArrayList[binaryTestStruct]()},
+		{"values", NewArrayListItems(binaryTestStruct{"a", 1}, binaryTestStruct{}, binaryTestStruct{"c", 3})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.list.MarshalBinary()
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			decoded := NewArrayListItems(binaryTestStruct{"old", 0})
+			if err = decoded.UnmarshalBinary(data); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if actual := decoded.ToArray(); !reflect.DeepEqual(actual, tt.list.ToArray()) {
+				t.Fatalf("UnmarshalBinary() got: %v, want: %v", actual, tt.list.ToArray())
+			}
+			linked := NewLinkedList[binaryTestStruct]()
+			if err = linked.UnmarshalBinary(data); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if actual := linked.ToArray(); !reflect.DeepEqual(actual, tt.list.ToArray()) {
+				t.Fatalf("LinkedList.UnmarshalBinary() got: %v, want: %v", actual, tt.list.ToArray())
+			}
+		})
+	}
+}
+
+func TestArrayList_UnmarshalBinary_fail(t *testing.T) {
+	data, _ := NewArrayListItems(1, 2, 3).MarshalBinary()
+	list := NewArrayListItems(7)
+	if err := list.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{7}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
+
+func TestArrayList_Gob(t *testing.T) {
+	type payload struct {
+		Items ArrayList[string]
+		Ptr   *ArrayList[int]
+	}
+	var buf bytes.Buffer
+	value := payload{Items: *NewArrayListItems("a", "b"), Ptr: NewArrayListItems(1, 2)}
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var decoded payload
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !reflect.DeepEqual(decoded.Items.ToArray(), []string{"a", "b"}) ||
+		!reflect.DeepEqual(decoded.Ptr.ToArray(), []int{1, 2}) {
+		t.Fatalf("gob decoding got: %v, %v", decoded.Items.ToArray(), decoded.Ptr.ToArray())
+	}
+}
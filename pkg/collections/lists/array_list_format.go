@@ -0,0 +1,47 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// String implements the fmt.Stringer interface.
+// Returns the elements of the list in square brackets (from the first to the last element), e.g. '[1 2 3]'.
+func (list ArrayList[T]) String() string {
+	return fmt.Sprint(list.ToArray())
+}
+
+// Format implements the fmt.Formatter interface.
+//   - %v - the elements of the list, e.g. '[1 2 3]'
+//   - %+v - the size and the capacity of the list followed by its elements
+//   - %#v - Go-syntax representation, e.g. 'lists.NewArrayListItems[int](1, 2, 3)'
+//   - other verbs are applied to the elements, e.g. %q or %x
+func (list ArrayList[T]) Format(f fmt.State, verb rune) {
+	details := fmt.Sprintf("size: %d, capacity: %d", len(list.values), list.capacity)
+	codec.Format(f, verb, "lists.NewArrayListItems", details, list.ToArray())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The list is encoded as a comma-separated (CSV) list of its elements (from the first to the last element).
+// The elements must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func (list ArrayList[T]) MarshalText() ([]byte, error) {
+	return codec.MarshalText(list.Values())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The list is decoded from a comma-separated (CSV) list of elements, replacing the current contents of the list.
+// The list is not changed if an error is returned.
+func (list *ArrayList[T]) UnmarshalText(text []byte) error {
+	var values []T
+	if err := codec.UnmarshalText(text, func(value T) { values = append(values, value) }); err != nil {
+		return err
+	}
+	list.Clear()
+	list.values = append(list.values, values...)
+	return nil
+}
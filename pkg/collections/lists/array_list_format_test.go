@@ -0,0 +1,63 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestArrayList_Format(t *testing.T) {
+	type holder struct {
+		List ArrayList[string]
+	}
+	list := NewArrayListItems("a", "b")
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", list, "[a b]"},
+		{"%q", list, `["a" "b"]`},
+		{"%+v", list, "{size: 2, capacity: 2, elements: [a b]}"},
+		{"%#v", list, `lists.NewArrayListItems[string]("a", "b")`},
+		{"%v", *list, "[a b]"},
+		{"%v", holder{*list}, "{[a b]}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if actual := fmt.Sprintf(tt.format, tt.arg); actual != tt.want {
+				t.Errorf("Sprintf(%s) got: '%s', want: '%s'", tt.format, actual, tt.want)
+			}
+		})
+	}
+	var stringer fmt.Stringer = *list
+	if actual := stringer.String(); actual != "[a b]" {
+		t.Fatalf("String() got: '%s', want: '%s'", actual, "[a b]")
+	}
+}
+
+func TestArrayList_MarshalText(t *testing.T) {
+	list := NewArrayListItems("a", "b c", "")
+	text, err := list.MarshalText()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	decoded := NewArrayListItems("old")
+	if err = decoded.UnmarshalText(text); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := decoded.ToArray(); !reflect.DeepEqual(actual, list.ToArray()) {
+		t.Fatalf("UnmarshalText() got: %q, want: %q", actual, list.ToArray())
+	}
+	ints := NewArrayListItems(1)
+	if err = ints.UnmarshalText([]byte("2,x")); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := ints.ToArray(); !reflect.DeepEqual(actual, []int{1}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
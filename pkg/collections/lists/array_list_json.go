@@ -0,0 +1,26 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import "encoding/json"
+
+// MarshalJSON implements the json.Marshaler interface.
+// The list is encoded as a JSON array of its elements in the proper sequence (from the first to the last element).
+func (list ArrayList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.ToArray())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The list is decoded from a JSON array, replacing the current contents of the list.
+// JSON null is decoded as an empty list.
+func (list *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	list.Clear()
+	list.values = append(list.values, values...)
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestArrayList_JSON(t *testing.T) {
+	type payload struct {
+		Items ArrayList[string]  `json:"items"`
+		Ptr   *ArrayList[int]    `json:"ptr"`
+		Empty ArrayList[float64] `json:"empty"`
+	}
+	value := payload{Items: *NewArrayListItems("c", "a", "b"), Ptr: NewArrayListItems(1)}
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	const want = `{"items":["c","a","b"],"ptr":[1],"empty":[]}`
+	if string(data) != want {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, want)
+	}
+	var decoded payload
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := decoded.Items.ToArray(); !reflect.DeepEqual(actual, []string{"c", "a", "b"}) {
+		t.Fatalf("UnmarshalJSON() got: %v, want: %v", actual, []string{"c", "a", "b"})
+	}
+	if actual := decoded.Ptr.ToArray(); !reflect.DeepEqual(actual, []int{1}) {
+		t.Fatalf("UnmarshalJSON() got: %v, want: %v", actual, []int{1})
+	}
+	list := NewArrayListItems(1, 2)
+	if err = json.Unmarshal([]byte(`null`), list); err != nil || !list.IsEmpty() {
+		t.Fatalf("UnmarshalJSON(null) got: %v, error: %v", list.ToArray(), err)
+	}
+	list.AddLast(3)
+	if err = json.Unmarshal([]byte(`["x"]`), list); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{3}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
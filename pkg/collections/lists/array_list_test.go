@@ -0,0 +1,215 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewArrayList(t *testing.T) {
+	list := NewArrayList[int]()
+	if !list.IsEmpty() || list.Size() != 0 || list.Capacity() != 0 {
+		t.Fatalf("invalid new list: size: %d, capacity: %d", list.Size(), list.Capacity())
+	}
+	if list = NewArrayListCapacity[int](10); list.Capacity() != 10 || list.Size() != 0 {
+		t.Fatalf("invalid new list: size: %d, capacity: %d", list.Size(), list.Capacity())
+	}
+	if list = NewArrayListItems(1, 2, 3); list.Size() != 3 {
+		t.Fatalf("invalid size, expected: %d, actual: %d", 3, list.Size())
+	}
+	var zero ArrayList[string]
+	zero.AddLast("a")
+	zero.AddFirst("b")
+	if actual := zero.ToArray(); !reflect.DeepEqual(actual, []string{"b", "a"}) {
+		t.Fatalf("zero value list got: %v, want: %v", actual, []string{"b", "a"})
+	}
+	if actual := (&ArrayList[int]{}).ToArray(); actual == nil || len(actual) != 0 {
+		t.Fatal("an empty array is expected")
+	}
+}
+
+func TestArrayList_empty(t *testing.T) {
+	list := NewArrayList[int]()
+	if _, ok := list.GetFirst(); ok {
+		t.Fatal("GetFirst() returned an element of an empty list")
+	}
+	if _, ok := list.GetLast(); ok {
+		t.Fatal("GetLast() returned an element of an empty list")
+	}
+	if _, ok := list.RemoveFirst(); ok {
+		t.Fatal("RemoveFirst() removed an element of an empty list")
+	}
+	if _, ok := list.RemoveLast(); ok {
+		t.Fatal("RemoveLast() removed an element of an empty list")
+	}
+	if _, index := list.RemoveFirstOccurrence(func(int) bool { return true }); index != -1 {
+		t.Fatalf("RemoveFirstOccurrence() index: %d, want: %d", index, -1)
+	}
+}
+
+func TestArrayList_index_fail(t *testing.T) {
+	list := NewArrayListItems(1, 2)
+	for _, index := range []int{-1, 2} {
+		if _, err := list.Get(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Get(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+		if _, err := list.Set(index, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Set(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+		if _, err := list.Remove(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Remove(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+	}
+	for _, index := range []int{-1, 3} {
+		if err := list.Insert(index, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("Insert(%d) error: %v, want: %v", index, err, ErrIndexOutOfRange)
+		}
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
+
+// TestArrayList_LinkedList performs the same random operations on an ArrayList and a LinkedList
+// and checks that both lists have the same contents.
+//
+//revive:disable:cyclomatic
+func TestArrayList_LinkedList(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	array := NewArrayList[int]()
+	linked := NewLinkedList[int]()
+	isOdd := func(value int) bool { return value%2 != 0 }
+	for i := 0; i < 2000; i++ {
+		value := rnd.Intn(100)
+		index := rnd.Intn(array.Size()+2) - 1
+		switch op := rnd.Intn(10); op {
+		case 0:
+			array.AddFirst(value)
+			linked.AddFirst(value)
+		case 1, 2:
+			array.AddLast(value)
+			linked.AddLast(value)
+		case 3:
+			errA := array.InsertAll(index, value, value+1)
+			errL := linked.InsertAll(index, value, value+1)
+			if !errors.Is(errA, errL) {
+				t.Fatalf("InsertAll() error: %v, want: %v", errA, errL)
+			}
+		case 4:
+			vA, errA := array.Set(index, value)
+			vL, errL := linked.Set(index, value)
+			if vA != vL || !errors.Is(errA, errL) {
+				t.Fatalf("Set() got: %d, %v, want: %d, %v", vA, errA, vL, errL)
+			}
+		case 5:
+			vA, errA := array.Remove(index)
+			vL, errL := linked.Remove(index)
+			if vA != vL || !errors.Is(errA, errL) {
+				t.Fatalf("Remove() got: %d, %v, want: %d, %v", vA, errA, vL, errL)
+			}
+		case 6:
+			vA, okA := array.RemoveFirst()
+			vL, okL := linked.RemoveFirst()
+			if vA != vL || okA != okL {
+				t.Fatalf("RemoveFirst() got: %d, %t, want: %d, %t", vA, okA, vL, okL)
+			}
+		case 7:
+			vA, okA := array.RemoveLast()
+			vL, okL := linked.RemoveLast()
+			if vA != vL || okA != okL {
+				t.Fatalf("RemoveLast() got: %d, %t, want: %d, %t", vA, okA, vL, okL)
+			}
+		case 8:
+			target := func(v int) bool { return v == value }
+			vA, iA := array.RemoveFirstOccurrence(target)
+			vL, iL := linked.RemoveFirstOccurrence(target)
+			if vA != vL || iA != iL {
+				t.Fatalf("RemoveFirstOccurrence() got: %d, %d, want: %d, %d", vA, iA, vL, iL)
+			}
+			vA, iA = array.RemoveLastOccurrence(target)
+			vL, iL = linked.RemoveLastOccurrence(target)
+			if vA != vL || iA != iL {
+				t.Fatalf("RemoveLastOccurrence() got: %d, %d, want: %d, %d", vA, iA, vL, iL)
+			}
+		case 9:
+			if i%50 == 9 {
+				if cA, cL := array.RemoveAll(isOdd), linked.RemoveAll(isOdd); cA != cL {
+					t.Fatalf("RemoveAll() got: %d, want: %d", cA, cL)
+				}
+			}
+		}
+		if !reflect.DeepEqual(array.ToArray(), linked.ToArray()) {
+			t.Fatalf("step %d: ArrayList: %v, LinkedList: %v", i, array.ToArray(), linked.ToArray())
+		}
+	}
+}
+
+//revive:enable:cyclomatic
+
+func TestArrayList_iterators(t *testing.T) {
+	list := NewArrayListItems("a", "b", "c")
+	var indexes []int
+	var values []string
+	for i, v := range list.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) || !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Fatalf("All() got: %v, %v", indexes, values)
+	}
+	if actual := slices.Collect(list.Values()); !reflect.DeepEqual(actual, []string{"a", "b", "c"}) {
+		t.Fatalf("Values() got: %v, want: %v", actual, []string{"a", "b", "c"})
+	}
+	values = values[:0]
+	for _, v := range list.Backward() {
+		values = append(values, v)
+		if len(values) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(values, []string{"c", "b"}) {
+		t.Fatalf("Backward() got: %v, want: %v", values, []string{"c", "b"})
+	}
+}
+
+func TestArrayList_Capacity(t *testing.T) {
+	list := NewArrayListCapacity[int](4)
+	for i := 0; i < 100; i++ {
+		list.AddLast(i)
+	}
+	if list.Capacity() != 4 || cap(list.values) < 100 {
+		t.Fatalf("Capacity() got: %d, want: %d", list.Capacity(), 4)
+	}
+	list.RemoveAll(func(value int) bool { return value >= 10 })
+	list.TrimToSize()
+	if list.Capacity() != 4 || cap(list.values) != 10 || list.Size() != 10 {
+		t.Fatalf("TrimToSize() capacity: %d, size: %d, want: %d, %d", list.Capacity(), list.Size(), 4, 10)
+	}
+	list.Clear()
+	if !list.IsEmpty() || list.Capacity() != 4 || cap(list.values) != 4 {
+		t.Fatalf("Clear() size: %d, capacity: %d", list.Size(), list.Capacity())
+	}
+}
+
+func TestArrayList_Sort(t *testing.T) {
+	type pair struct {
+		key, value int
+	}
+	list := NewArrayListItems(pair{2, 0}, pair{1, 1}, pair{2, 2}, pair{1, 3})
+	list.SortStable(func(a, b pair) bool { return a.key < b.key })
+	want := []pair{{1, 1}, {1, 3}, {2, 0}, {2, 2}}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("SortStable() got: %v, want: %v", actual, want)
+	}
+	ints := NewArrayListItems(5, 3, 9, 1)
+	ints.Sort(func(a, b int) bool { return a > b })
+	if actual := ints.ToArray(); !reflect.DeepEqual(actual, []int{9, 5, 3, 1}) {
+		t.Fatalf("Sort() got: %v, want: %v", actual, []int{9, 5, 3, 1})
+	}
+}
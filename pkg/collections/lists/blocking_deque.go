@@ -0,0 +1,205 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrDequeClosed error: 'deque is closed'
+	ErrDequeClosed = errors.New("deque is closed")
+	// ErrDequeFull error: 'deque is full'
+	ErrDequeFull = errors.New("deque is full")
+)
+
+// BlockingDeque is a thread safe double-ended queue based on LinkedList.
+// In addition to non-blocking operations, it provides operations that wait for the deque
+// to become non-empty when retrieving an element, and wait for space to become available
+// when storing an element into a deque with a bounded capacity.
+// The zero value of BlockingDeque is an empty unbounded deque ready to use.
+//   - T - value type
+type BlockingDeque[T any] struct {
+	mu       sync.Mutex
+	list     LinkedList[T]
+	capacity int
+	closed   bool
+	changed  notifier
+}
+
+func (dq *BlockingDeque[T]) isFull() bool {
+	return dq.capacity > 0 && dq.list.Size() >= dq.capacity
+}
+
+func (dq *BlockingDeque[T]) push(value T, front bool) error {
+	if dq.closed {
+		return ErrDequeClosed
+	}
+	if dq.isFull() {
+		return ErrDequeFull
+	}
+	if front {
+		dq.list.AddFirst(value)
+	} else {
+		dq.list.AddLast(value)
+	}
+	dq.changed.notify()
+	return nil
+}
+
+// PushFront inserts the specified value at the front of this deque without waiting.
+// Returns ErrDequeClosed if the deque is closed or ErrDequeFull if the deque is full.
+//   - value - the value to be inserted
+func (dq *BlockingDeque[T]) PushFront(value T) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.push(value, true)
+}
+
+// PushBack inserts the specified value at the end of this deque without waiting.
+// Returns ErrDequeClosed if the deque is closed or ErrDequeFull if the deque is full.
+//   - value - the value to be inserted
+func (dq *BlockingDeque[T]) PushBack(value T) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.push(value, false)
+}
+
+// Put inserts the specified value at the end of this deque, waiting if necessary for space to become available.
+// Returns ErrDequeClosed if the deque is closed or the context error if the context is done before
+// the value was inserted.
+//   - ctx - the context that cancels waiting
+//   - value - the value to be inserted
+func (dq *BlockingDeque[T]) Put(ctx context.Context, value T) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	for !dq.closed && dq.isFull() {
+		if err := dq.changed.wait(ctx, &dq.mu); err != nil {
+			return err
+		}
+	}
+	return dq.push(value, false)
+}
+
+func (dq *BlockingDeque[T]) pop(front bool) (T, bool) {
+	var res T
+	var ok bool
+	if front {
+		res, ok = dq.list.RemoveFirst()
+	} else {
+		res, ok = dq.list.RemoveLast()
+	}
+	if ok {
+		dq.changed.notify()
+	}
+	return res, ok
+}
+
+// PopFront removes the first element of this deque without waiting and returns its value and true if it exists.
+// If the deque is empty, a default value of type T and false is returned.
+func (dq *BlockingDeque[T]) PopFront() (T, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.pop(true)
+}
+
+// PopBack removes the last element of this deque without waiting and returns its value and true if it exists.
+// If the deque is empty, a default value of type T and false is returned.
+func (dq *BlockingDeque[T]) PopBack() (T, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.pop(false)
+}
+
+func (dq *BlockingDeque[T]) take(ctx context.Context, front bool) (T, error) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	for dq.list.Size() == 0 {
+		var res T
+		if dq.closed {
+			return res, ErrDequeClosed
+		}
+		if err := dq.changed.wait(ctx, &dq.mu); err != nil {
+			return res, err
+		}
+	}
+	res, _ := dq.pop(front)
+	return res, nil
+}
+
+// TakeFirst removes the first element of this deque and returns its value,
+// waiting if necessary until an element becomes available.
+// Returns ErrDequeClosed if the deque is closed and empty or the context error if the context is done
+// before an element became available.
+//   - ctx - the context that cancels waiting
+func (dq *BlockingDeque[T]) TakeFirst(ctx context.Context) (T, error) {
+	return dq.take(ctx, true)
+}
+
+// TakeLast removes the last element of this deque and returns its value,
+// waiting if necessary until an element becomes available.
+// Returns ErrDequeClosed if the deque is closed and empty or the context error if the context is done
+// before an element became available.
+//   - ctx - the context that cancels waiting
+func (dq *BlockingDeque[T]) TakeLast(ctx context.Context) (T, error) {
+	return dq.take(ctx, false)
+}
+
+// Close closes the deque and wakes up all waiting goroutines.
+// After closing, no new elements can be inserted, but the remaining elements can still be retrieved.
+// Returns false if the deque was already closed.
+func (dq *BlockingDeque[T]) Close() bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return false
+	}
+	dq.closed = true
+	dq.changed.notify()
+	return true
+}
+
+// IsClosed returns true if the deque is closed.
+func (dq *BlockingDeque[T]) IsClosed() bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.closed
+}
+
+// Size returns the number of elements in this deque
+func (dq *BlockingDeque[T]) Size() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.list.Size()
+}
+
+// Capacity returns the maximum number of elements in this deque, 0 means the deque is unbounded.
+func (dq *BlockingDeque[T]) Capacity() int {
+	return dq.capacity
+}
+
+// ToArray returns an array containing all elements of this deque in the proper sequence
+// (from the first to the last element).
+func (dq *BlockingDeque[T]) ToArray() []T {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.list.ToArray()
+}
+
+// NewBlockingDeque constructs an empty unbounded deque
+func NewBlockingDeque[T any]() *BlockingDeque[T] {
+	return NewBlockingDequeCapacity[T](0)
+}
+
+// NewBlockingDequeCapacity constructs an empty deque that contains no more than the specified number of elements.
+//   - capacity - the maximum number of elements; if it is less than 1, the deque is unbounded
+func NewBlockingDequeCapacity[T any](capacity int) *BlockingDeque[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &BlockingDeque[T]{capacity: capacity}
+}
@@ -0,0 +1,233 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingDeque_Push_Pop(t *testing.T) {
+	dq := NewBlockingDeque[int]()
+	for _, push := range []func(int) error{dq.PushBack, dq.PushFront, dq.PushBack} {
+		if err := push(dq.Size() + 1); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+	want := []int{2, 1, 3}
+	if actual := dq.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("got: %v, want: %v", actual, want)
+	}
+	if v, ok := dq.PopBack(); !ok || v != 3 {
+		t.Fatalf("PopBack() got: %v, %t, want: %v, true", v, ok, 3)
+	}
+	if v, ok := dq.PopFront(); !ok || v != 2 {
+		t.Fatalf("PopFront() got: %v, %t, want: %v, true", v, ok, 2)
+	}
+	if v, ok := dq.PopFront(); !ok || v != 1 {
+		t.Fatalf("PopFront() got: %v, %t, want: %v, true", v, ok, 1)
+	}
+	if v, ok := dq.PopBack(); ok || v != 0 {
+		t.Fatalf("PopBack() got: %v, %t, want: %v, false", v, ok, 0)
+	}
+}
+
+func TestBlockingDeque_capacity(t *testing.T) {
+	dq := NewBlockingDequeCapacity[int](2)
+	if dq.Capacity() != 2 {
+		t.Fatalf("unexpected capacity: %d, want: %d", dq.Capacity(), 2)
+	}
+	if err := dq.PushBack(1); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if err := dq.PushFront(2); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if err := dq.PushBack(3); !errors.Is(err, ErrDequeFull) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrDequeFull, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := dq.Put(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected error: '%v', got: '%v'", context.DeadlineExceeded, err)
+	}
+	if dq.Size() != 2 {
+		t.Fatalf("unexpected size: %d, want: %d", dq.Size(), 2)
+	}
+}
+
+func TestBlockingDeque_Put_wait(t *testing.T) {
+	dq := NewBlockingDequeCapacity[int](1)
+	_ = dq.PushBack(1)
+	done := make(chan error)
+	go func() {
+		done <- dq.Put(context.Background(), 2)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if v, _ := dq.PopFront(); v != 1 {
+		t.Fatalf("PopFront() got: %v, want: %v", v, 1)
+	}
+	if err := <-done; err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := dq.ToArray(); !reflect.DeepEqual(actual, []int{2}) {
+		t.Fatalf("got: %v, want: %v", actual, []int{2})
+	}
+}
+
+func TestBlockingDeque_Take(t *testing.T) {
+	dq := NewBlockingDeque[string]()
+	ctx := context.Background()
+	results := make(chan string, 2)
+	var wg sync.WaitGroup
+	for _, take := range []func(context.Context) (string, error){dq.TakeFirst, dq.TakeLast} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := take(ctx)
+			if err != nil {
+				t.Error("unexpected error:", err)
+			}
+			results <- v
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	_ = dq.PushBack("one")
+	_ = dq.PushBack("two")
+	wg.Wait()
+	close(results)
+	var actual []string
+	for v := range results {
+		actual = append(actual, v)
+	}
+	sort.Strings(actual)
+	if !reflect.DeepEqual(actual, []string{"one", "two"}) {
+		t.Fatalf("got: %v, want: %v", actual, []string{"one", "two"})
+	}
+}
+
+func TestBlockingDeque_Take_cancel(t *testing.T) {
+	dq := NewBlockingDeque[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := dq.TakeLast(ctx)
+		done <- err
+	}()
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error: '%v', got: '%v'", context.Canceled, err)
+	}
+}
+
+func TestBlockingDeque_Close(t *testing.T) {
+	dq := NewBlockingDequeCapacity[int](1)
+	_ = dq.PushBack(1)
+	ctx := context.Background()
+	putDone := make(chan error)
+	go func() {
+		putDone <- dq.Put(ctx, 2)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if !dq.Close() {
+		t.Fatal("the deque was not closed")
+	}
+	if dq.Close() {
+		t.Fatal("the deque was closed twice")
+	}
+	if !dq.IsClosed() {
+		t.Fatal("the deque is not closed")
+	}
+	if err := <-putDone; !errors.Is(err, ErrDequeClosed) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrDequeClosed, err)
+	}
+	if err := dq.PushFront(3); !errors.Is(err, ErrDequeClosed) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrDequeClosed, err)
+	}
+	if v, err := dq.TakeFirst(ctx); err != nil || v != 1 {
+		t.Fatalf("TakeFirst() got: %v, %v, want: %v, nil", v, err, 1)
+	}
+	if _, err := dq.TakeFirst(ctx); !errors.Is(err, ErrDequeClosed) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrDequeClosed, err)
+	}
+}
+
+func TestBlockingDeque_concurrent(t *testing.T) {
+	const producers = 4
+	const amount = 500
+	dq := NewBlockingDequeCapacity[int](10)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				if err := dq.Put(ctx, i); err != nil {
+					t.Error("unexpected error:", err)
+				}
+			}
+		}()
+	}
+	sum := 0
+	for i := 0; i < producers*amount; i++ {
+		v, err := dq.TakeFirst(ctx)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		sum += v
+	}
+	wg.Wait()
+	if want := producers * amount * (amount - 1) / 2; sum != want {
+		t.Fatalf("unexpected sum: %d, want: %d", sum, want)
+	}
+}
+
+func TestBlockingDeque_zero_value(t *testing.T) {
+	var dq BlockingDeque[int]
+	if err := dq.PushBack(1); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if err := dq.PushFront(0); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if dq.changed.changed != nil {
+		t.Fatal("a channel was allocated although nobody is waiting")
+	}
+	done := make(chan int)
+	go func() {
+		_, _ = dq.TakeFirst(context.Background())
+		_, _ = dq.TakeFirst(context.Background())
+		v, _ := dq.TakeFirst(context.Background())
+		done <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := dq.PushBack(2); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if v := <-done; v != 2 {
+		t.Fatalf("TakeFirst() got: %v, want: %v", v, 2)
+	}
+	if !dq.Close() || dq.Capacity() != 0 {
+		t.Fatal("the deque was not closed")
+	}
+}
+
+func TestBlockingDeque_cancelled_waiter(t *testing.T) {
+	dq := NewBlockingDeque[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := dq.TakeLast(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected error: '%v', got: '%v'", context.DeadlineExceeded, err)
+	}
+	if dq.changed.waiters != 0 {
+		t.Fatalf("unexpected number of waiters: %d, want: %d", dq.changed.waiters, 0)
+	}
+}
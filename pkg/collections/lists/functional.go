@@ -0,0 +1,127 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+// Map returns a new list containing the results of applying the mapper function to the elements of the list.
+//   - mapper - the function that is applied to each element
+func Map[T, R any](list *LinkedList[T], mapper func(value T) R) *LinkedList[R] {
+	result := NewLinkedList[R]()
+	for item := list.first; item != nil; item = item.next {
+		result.AddLast(mapper(item.value))
+	}
+	return result
+}
+
+// FlatMap returns a new list containing the concatenated results of applying the mapper function
+// to the elements of the list.
+//   - mapper - the function that is applied to each element and returns the values to be added to the result
+func FlatMap[T, R any](list *LinkedList[T], mapper func(value T) []R) *LinkedList[R] {
+	result := NewLinkedList[R]()
+	for item := list.first; item != nil; item = item.next {
+		for _, value := range mapper(item.value) {
+			result.AddLast(value)
+		}
+	}
+	return result
+}
+
+// Filter returns a new list containing the elements of the list that satisfy the condition.
+//   - match - the function that is applied to each element to determine if it should be included in the result
+func Filter[T any](list *LinkedList[T], match func(value T) bool) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for item := list.first; item != nil; item = item.next {
+		if match(item.value) {
+			result.AddLast(item.value)
+		}
+	}
+	return result
+}
+
+// Reduce performs a reduction of the elements of the list (from the first to the last element)
+// using the initial value and the accumulator function, and returns the reduced value.
+//   - initial - the initial value of the reduction
+//   - accumulator - the function that combines the accumulated value with the next element
+func Reduce[T, R any](list *LinkedList[T], initial R, accumulator func(acc R, value T) R) R {
+	result := initial
+	for item := list.first; item != nil; item = item.next {
+		result = accumulator(result, item.value)
+	}
+	return result
+}
+
+// GroupBy groups the elements of the list by the key returned by the classifier function.
+// Returns a map of keys to lists containing the elements in their original order.
+//   - classifier - the function that returns the key of an element
+func GroupBy[T any, K comparable](list *LinkedList[T], classifier func(value T) K) map[K]*LinkedList[T] {
+	result := make(map[K]*LinkedList[T])
+	for item := list.first; item != nil; item = item.next {
+		key := classifier(item.value)
+		group, ok := result[key]
+		if !ok {
+			group = NewLinkedList[T]()
+			result[key] = group
+		}
+		group.AddLast(item.value)
+	}
+	return result
+}
+
+// Partition splits the list into two new lists: the first contains the elements that satisfy the condition,
+// the second contains the rest of the elements.
+//   - match - the function that is applied to each element to determine the list it belongs to
+func Partition[T any](list *LinkedList[T], match func(value T) bool) (*LinkedList[T], *LinkedList[T]) {
+	matched, rest := NewLinkedList[T](), NewLinkedList[T]()
+	for item := list.first; item != nil; item = item.next {
+		if match(item.value) {
+			matched.AddLast(item.value)
+		} else {
+			rest.AddLast(item.value)
+		}
+	}
+	return matched, rest
+}
+
+// AnyMatch returns true if at least one element of the list satisfies the condition.
+// Returns false if the list is empty.
+//   - match - the function that is applied to the elements
+func AnyMatch[T any](list *LinkedList[T], match func(value T) bool) bool {
+	for item := list.first; item != nil; item = item.next {
+		if match(item.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if all elements of the list satisfy the condition.
+// Returns true if the list is empty.
+//   - match - the function that is applied to the elements
+func AllMatch[T any](list *LinkedList[T], match func(value T) bool) bool {
+	for item := list.first; item != nil; item = item.next {
+		if !match(item.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch returns true if no elements of the list satisfy the condition.
+// Returns true if the list is empty.
+//   - match - the function that is applied to the elements
+func NoneMatch[T any](list *LinkedList[T], match func(value T) bool) bool {
+	return !AnyMatch(list, match)
+}
+
+// Count returns the number of elements of the list that satisfy the condition.
+//   - match - the function that is applied to each element
+func Count[T any](list *LinkedList[T], match func(value T) bool) int {
+	count := 0
+	for item := list.first; item != nil; item = item.next {
+		if match(item.value) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,112 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3)
+	actual := Map(list, func(value int) string { return fmt.Sprint("v", value) }).ToArray()
+	want := []string{"v1", "v2", "v3"}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Map() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	list := NewLinkedListItems[int](1, 0, 2)
+	actual := FlatMap(list, func(value int) []int {
+		result := make([]int, value)
+		for i := range result {
+			result[i] = value
+		}
+		return result
+	}).ToArray()
+	want := []int{1, 2, 2}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("FlatMap() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3, 4, 5)
+	actual := Filter(list, func(value int) bool { return value%2 != 0 }).ToArray()
+	want := []int{1, 3, 5}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Filter() got: %v, want: %v", actual, want)
+	}
+	if list.Size() != 5 {
+		t.Fatal("the source list was changed")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	list := NewLinkedListItems[string]("a", "b", "c")
+	actual := Reduce(list, ">", func(acc string, value string) string { return acc + value })
+	if actual != ">abc" {
+		t.Fatalf("Reduce() got: '%s', want: '%s'", actual, ">abc")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3, 4, 5, 6, 7)
+	groups := GroupBy(list, func(value int) int { return value % 3 })
+	want := map[int][]int{0: {3, 6}, 1: {1, 4, 7}, 2: {2, 5}}
+	if len(groups) != len(want) {
+		t.Fatalf("GroupBy() unexpected number of groups: %d, want: %d", len(groups), len(want))
+	}
+	for key, values := range want {
+		if actual := groups[key].ToArray(); !reflect.DeepEqual(actual, values) {
+			t.Fatalf("GroupBy() key: %d, got: %v, want: %v", key, actual, values)
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3, 4, 5)
+	matched, rest := Partition(list, func(value int) bool { return value > 3 })
+	if actual := matched.ToArray(); !reflect.DeepEqual(actual, []int{4, 5}) {
+		t.Fatalf("Partition() matched: %v, want: %v", actual, []int{4, 5})
+	}
+	if actual := rest.ToArray(); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("Partition() rest: %v, want: %v", actual, []int{1, 2, 3})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	positive := func(value int) bool { return value > 0 }
+	tests := []struct {
+		list      *LinkedList[int]
+		wantAny   bool
+		wantAll   bool
+		wantNone  bool
+		wantCount int
+	}{
+		{NewLinkedList[int](), false, true, true, 0},
+		{NewLinkedListItems[int](1, 2), true, true, false, 2},
+		{NewLinkedListItems[int](-1, 2, 3), true, false, false, 2},
+		{NewLinkedListItems[int](-1, 0), false, false, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.list.ToArray()), func(t *testing.T) {
+			if got := AnyMatch(tt.list, positive); got != tt.wantAny {
+				t.Errorf("AnyMatch() = %t, want %t", got, tt.wantAny)
+			}
+			if got := AllMatch(tt.list, positive); got != tt.wantAll {
+				t.Errorf("AllMatch() = %t, want %t", got, tt.wantAll)
+			}
+			if got := NoneMatch(tt.list, positive); got != tt.wantNone {
+				t.Errorf("NoneMatch() = %t, want %t", got, tt.wantNone)
+			}
+			if got := Count(tt.list, positive); got != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+// Iterator is a bidirectional cursor over a LinkedList.
+// The iterator either points to an element of the list or is positioned between two adjacent elements
+// (before the first or after the last element, or in place of a removed element).
+// All modifications made through the iterator take O(1) time and keep the list consistent.
+// The iterator becomes invalid if the list is structurally modified in any other way.
+type Iterator[T any] struct {
+	list *LinkedList[T]
+	item *listItem[T]
+	prev *listItem[T]
+	next *listItem[T]
+}
+
+// Next moves the iterator to the next element of the list.
+// Returns true if the iterator points to an element, or false if the end of the list has been reached.
+func (it *Iterator[T]) Next() bool {
+	if it.item != nil {
+		it.prev, it.next = it.item, it.item.next
+	}
+	it.item = it.next
+	return it.item != nil
+}
+
+// Prev moves the iterator to the previous element of the list.
+// Returns true if the iterator points to an element, or false if the beginning of the list has been reached.
+func (it *Iterator[T]) Prev() bool {
+	if it.item != nil {
+		it.prev, it.next = it.item.prev, it.item
+	}
+	it.item = it.prev
+	return it.item != nil
+}
+
+// Value returns the value of the current element and true if the iterator points to an element.
+// Otherwise, a default value of type T and false is returned.
+func (it *Iterator[T]) Value() (T, bool) {
+	if it.item != nil {
+		return it.item.value, true
+	}
+	var res T
+	return res, false
+}
+
+// Set replaces the value of the current element with the specified value.
+// Returns false if the iterator does not point to an element.
+//   - value - the new value of the element
+func (it *Iterator[T]) Set(value T) bool {
+	if it.item != nil {
+		it.item.value = value
+		return true
+	}
+	return false
+}
+
+// InsertBefore inserts the specified value before the current element.
+// If the iterator does not point to an element, the value is inserted at the current position of the iterator,
+// so that the next call of Prev moves to the inserted element.
+//   - value - the value to be inserted
+func (it *Iterator[T]) InsertBefore(value T) {
+	if it.item != nil {
+		it.list.insertBetween(it.item.prev, it.item, value)
+	} else {
+		it.prev = it.list.insertBetween(it.prev, it.next, value)
+	}
+}
+
+// InsertAfter inserts the specified value after the current element.
+// If the iterator does not point to an element, the value is inserted at the current position of the iterator,
+// so that the next call of Next moves to the inserted element.
+//   - value - the value to be inserted
+func (it *Iterator[T]) InsertAfter(value T) {
+	if it.item != nil {
+		it.list.insertBetween(it.item, it.item.next, value)
+	} else {
+		it.next = it.list.insertBetween(it.prev, it.next, value)
+	}
+}
+
+// Remove removes the current element from the list and returns its value and true.
+// After removal the iterator is positioned between the neighbours of the removed element,
+// so both Next and Prev continue the traversal correctly.
+// If the iterator does not point to an element, a default value of type T and false is returned.
+func (it *Iterator[T]) Remove() (T, bool) {
+	if it.item != nil {
+		it.prev, it.next = it.item.prev, it.item.next
+		res := it.list.removeItem(it.item)
+		it.item = nil
+		return res, true
+	}
+	var res T
+	return res, false
+}
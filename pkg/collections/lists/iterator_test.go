@@ -0,0 +1,218 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"reflect"
+	"testing"
+)
+
+func checkListLinks[T any](t *testing.T, list *LinkedList[T]) {
+	t.Helper()
+	count := 0
+	var prev *listItem[T]
+	for item := list.first; item != nil; item = item.next {
+		if item.prev != prev {
+			t.Fatalf("invalid 'prev' link of the item %d", count)
+		}
+		prev = item
+		count++
+	}
+	if list.last != prev {
+		t.Fatal("invalid 'last' item")
+	}
+	if list.size != count {
+		t.Fatalf("invalid list size: %d, want: %d", list.size, count)
+	}
+}
+
+func TestIterator_Next(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3)
+	it := list.Iterator()
+	var actual []int
+	for it.Next() {
+		v, ok := it.Value()
+		if !ok {
+			t.Fatal("the iterator must point to an element")
+		}
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("Next() got: %v, want: %v", actual, []int{1, 2, 3})
+	}
+	if _, ok := it.Value(); ok {
+		t.Fatal("the iterator must not point to an element")
+	}
+	if !it.Prev() {
+		t.Fatal("the iterator must move back to the last element")
+	}
+	if v, _ := it.Value(); v != 3 {
+		t.Fatalf("Prev() got: %v, want: %v", v, 3)
+	}
+}
+
+func TestIterator_Prev(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3)
+	it := list.BackwardIterator()
+	var actual []int
+	for it.Prev() {
+		v, _ := it.Value()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(actual, []int{3, 2, 1}) {
+		t.Fatalf("Prev() got: %v, want: %v", actual, []int{3, 2, 1})
+	}
+	if !it.Next() {
+		t.Fatal("the iterator must move forward to the first element")
+	}
+	if v, _ := it.Value(); v != 1 {
+		t.Fatalf("Next() got: %v, want: %v", v, 1)
+	}
+}
+
+func TestIterator_empty(t *testing.T) {
+	list := NewLinkedList[string]()
+	it := list.Iterator()
+	if it.Next() || it.Prev() {
+		t.Fatal("the iterator of an empty list must not move")
+	}
+	if it.Set("value") {
+		t.Fatal("the value was set without a current element")
+	}
+	if _, ok := it.Remove(); ok {
+		t.Fatal("the value was removed without a current element")
+	}
+	it.InsertAfter("value")
+	checkListLinks(t, list)
+	if !it.Next() {
+		t.Fatal("the iterator must move to the inserted element")
+	}
+	if v, _ := it.Value(); v != "value" {
+		t.Fatalf("Value() got: '%s', want: '%s'", v, "value")
+	}
+}
+
+func TestIterator_Set(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3)
+	for it := list.Iterator(); it.Next(); {
+		v, _ := it.Value()
+		if !it.Set(v * 10) {
+			t.Fatal("the value was not set")
+		}
+	}
+	actual := list.ToArray()
+	if !reflect.DeepEqual(actual, []int{10, 20, 30}) {
+		t.Fatalf("Set() got: %v, want: %v", actual, []int{10, 20, 30})
+	}
+}
+
+func TestIterator_Remove(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      *LinkedList[int]
+		wantArray []int
+	}{
+		{"single", NewLinkedListItems[int](2), []int{}},
+		{"first", NewLinkedListItems[int](2, 1, 3), []int{1, 3}},
+		{"last", NewLinkedListItems[int](1, 3, 2), []int{1, 3}},
+		{"all", NewLinkedListItems[int](2, 4, 6), []int{}},
+		{"middle", NewLinkedListItems[int](1, 2, 4, 3, 6, 5), []int{1, 3, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := tt.list.ToArray()
+			var visited []int
+			for it := tt.list.Iterator(); it.Next(); {
+				v, _ := it.Value()
+				visited = append(visited, v)
+				if v%2 == 0 {
+					if removed, ok := it.Remove(); !ok || removed != v {
+						t.Fatalf("Remove() got: %v, %t, want: %v, true", removed, ok, v)
+					}
+				}
+			}
+			if !reflect.DeepEqual(visited, source) {
+				t.Fatalf("visited got: %v, want: %v", visited, source)
+			}
+			actual := tt.list.ToArray()
+			if !reflect.DeepEqual(actual, tt.wantArray) {
+				t.Errorf("Remove() got: %v, want: %v", actual, tt.wantArray)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
+
+func TestIterator_Remove_backward(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3, 4)
+	it := list.BackwardIterator()
+	var visited []int
+	for it.Prev() {
+		v, _ := it.Value()
+		visited = append(visited, v)
+		if v%2 == 1 {
+			it.Remove()
+		}
+	}
+	if !reflect.DeepEqual(visited, []int{4, 3, 2, 1}) {
+		t.Fatalf("visited got: %v, want: %v", visited, []int{4, 3, 2, 1})
+	}
+	actual := list.ToArray()
+	if !reflect.DeepEqual(actual, []int{2, 4}) {
+		t.Fatalf("Remove() got: %v, want: %v", actual, []int{2, 4})
+	}
+	checkListLinks(t, list)
+}
+
+func TestIterator_InsertBefore(t *testing.T) {
+	list := NewLinkedListItems[int](1, 3, 5)
+	for it := list.Iterator(); it.Next(); {
+		v, _ := it.Value()
+		it.InsertBefore(v - 1)
+	}
+	actual := list.ToArray()
+	want := []int{0, 1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("InsertBefore() got: %v, want: %v", actual, want)
+	}
+	checkListLinks(t, list)
+}
+
+func TestIterator_InsertAfter(t *testing.T) {
+	list := NewLinkedListItems[int](1, 3, 5)
+	for it := list.Iterator(); it.Next(); {
+		v, _ := it.Value()
+		it.InsertAfter(v + 1)
+		it.Next()
+	}
+	actual := list.ToArray()
+	want := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("InsertAfter() got: %v, want: %v", actual, want)
+	}
+	checkListLinks(t, list)
+}
+
+func TestIterator_Insert_removed_position(t *testing.T) {
+	list := NewLinkedListItems[string]("a", "x", "d")
+	it := list.Iterator()
+	it.Next()
+	it.Next()
+	it.Remove()
+	it.InsertBefore("b")
+	it.InsertAfter("c")
+	actual := list.ToArray()
+	want := []string{"a", "b", "c", "d"}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("got: %v, want: %v", actual, want)
+	}
+	checkListLinks(t, list)
+	if !it.Next() {
+		t.Fatal("the iterator must move to the next element")
+	}
+	if v, _ := it.Value(); v != "c" {
+		t.Fatalf("Next() got: '%s', want: '%s'", v, "c")
+	}
+}
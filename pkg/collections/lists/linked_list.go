@@ -5,7 +5,10 @@
 // Package lists contains ordered collections and their manipulation
 package lists
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
 var (
 	// ErrIndexOutOfRange error: 'index is out of range'
@@ -76,6 +79,52 @@ func (list *LinkedList[T]) Get(index int) (T, error) {
 	return res, err
 }
 
+// Set replaces the element at the specified position in this list with the specified value.
+// Returns the value previously at the specified position
+// or a default value of type T and an error if the index is out of range.
+//   - index - index of the element to replace
+//   - value - the value to be stored at the specified position
+func (list *LinkedList[T]) Set(index int, value T) (T, error) {
+	item, err := list.getByIndex(index)
+	var old T
+	if err == nil {
+		old, item.value = item.value, value
+	}
+	return old, err
+}
+
+// Insert inserts the specified value at the specified position in this list.
+// Shifts the element currently at that position (if any) and any subsequent elements to the right.
+// Returns an error if the index is out of range (index < 0 || index > Size()).
+//   - index - index at which the specified value is to be inserted
+//   - value - the value to be inserted
+func (list *LinkedList[T]) Insert(index int, value T) error {
+	return list.InsertAll(index, value)
+}
+
+// InsertAll inserts all the specified values at the specified position in this list in the order they are given.
+// Shifts the element currently at that position (if any) and any subsequent elements to the right.
+// Returns an error if the index is out of range (index < 0 || index > Size()).
+//   - index - index at which to insert the first of the specified values
+//   - values - the values to be inserted
+func (list *LinkedList[T]) InsertAll(index int, values ...T) error {
+	if index < 0 || index > list.size {
+		return ErrIndexOutOfRange
+	}
+	var next *listItem[T]
+	if index < list.size {
+		next, _ = list.getByIndex(index)
+	}
+	prev := list.last
+	if next != nil {
+		prev = next.prev
+	}
+	for _, value := range values {
+		prev = list.insertBetween(prev, next, value)
+	}
+	return nil
+}
+
 // RemoveFirst removes the first item from this list and returns its value and true if it exists.
 // If the list is empty, a default value of type T and false is returned.
 func (list *LinkedList[T]) RemoveFirst() (T, bool) {
@@ -172,15 +221,52 @@ func (list *LinkedList[T]) RemoveAll(needRemove func(value T) bool) int {
 	}
 	return count
 }
+func (list *LinkedList[T]) insertBetween(prev, next *listItem[T], value T) *listItem[T] {
+	item := &listItem[T]{prev: prev, next: next, value: value}
+	if prev != nil {
+		prev.next = item
+	} else {
+		list.first = item
+	}
+	if next != nil {
+		next.prev = item
+	} else {
+		list.last = item
+	}
+	list.size++
+	return item
+}
 func (list *LinkedList[T]) getByIndex(index int) (*listItem[T], error) {
-	if index >= 0 && index < list.size {
-		for i, item := 0, list.first; item != nil; i, item = i+1, item.next {
-			if i == index {
-				return item, nil
-			}
+	if index < 0 || index >= list.size {
+		return nil, ErrIndexOutOfRange
+	}
+	if index < list.size/2 {
+		item := list.first
+		for i := 0; i < index; i++ {
+			item = item.next
 		}
+		return item, nil
+	}
+	item := list.last
+	for i := list.size - 1; i > index; i-- {
+		item = item.prev
 	}
-	return nil, ErrIndexOutOfRange
+	return item, nil
+}
+
+// Sort sorts the list according to the order specified by the less function.
+// It uses the merge sort of SortListStable, so it takes O(n*log(n)) time even on a sorted list,
+// unlike the quicksort of SortList.
+//   - less - the function used to compare list elements
+func (list *LinkedList[T]) Sort(less func(item1, item2 T) bool) {
+	SortListStable(list, less)
+}
+
+// SortStable sorts the list according to the order specified by the less function
+// keeping the original order of equal elements, the same as SortListStable.
+//   - less - the function used to compare list elements
+func (list *LinkedList[T]) SortStable(less func(item1, item2 T) bool) {
+	SortListStable(list, less)
 }
 
 // ToArray returns an array containing all elements of this list in the proper sequence
@@ -193,6 +279,60 @@ func (list *LinkedList[T]) ToArray() []T {
 	return result
 }
 
+// ToSlice returns a slice containing all elements of this list in the proper sequence
+// (from the first to the last element). It is the same as ToArray and is provided to match the other collections.
+func (list *LinkedList[T]) ToSlice() []T {
+	return list.ToArray()
+}
+
+// All returns an iterator over index-value pairs of this list in the proper sequence
+// (from the first to the last element).
+func (list *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := 0, list.first; item != nil; i, item = i+1, item.next {
+			if !yield(i, item.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this list in the proper sequence
+// (from the first to the last element).
+func (list *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := list.first; item != nil; item = item.next {
+			if !yield(item.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of this list in reverse order
+// (from the last to the first element).
+func (list *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := list.size-1, list.last; item != nil; i, item = i-1, item.prev {
+			if !yield(i, item.value) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns an Iterator positioned before the first element of this list,
+// so the first call of Next moves it to the first element.
+func (list *LinkedList[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{list: list, next: list.first}
+}
+
+// BackwardIterator returns an Iterator positioned after the last element of this list,
+// so the first call of Prev moves it to the last element.
+func (list *LinkedList[T]) BackwardIterator() *Iterator[T] {
+	return &Iterator[T]{list: list, prev: list.last}
+}
+
 // Clear clears this list
 func (list *LinkedList[T]) Clear() {
 	list.first = nil
@@ -205,6 +345,11 @@ func (list *LinkedList[T]) Size() int {
 	return list.size
 }
 
+// IsEmpty returns true if this list does not contain any elements
+func (list *LinkedList[T]) IsEmpty() bool {
+	return list.size == 0
+}
+
 // NewLinkedList constructs an empty list
 func NewLinkedList[T any]() *LinkedList[T] {
 	return &LinkedList[T]{}
@@ -0,0 +1,55 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// WriteBinary writes the list to the writer in a versioned length-prefixed binary format,
+// in which the elements are encoded with encoding/gob one by one (from the first to the last element)
+// without copying them to a slice.
+func (list LinkedList[T]) WriteBinary(w io.Writer) error {
+	return codec.Encode(w, list.size, list.Values())
+}
+
+// ReadBinary reads the list written by WriteBinary from the reader, replacing the current contents of the list.
+// The elements are appended to the list as they are decoded. The list is not changed if an error is returned.
+// If the reader does not implement io.ByteReader, it may be read beyond the end of the list data.
+func (list *LinkedList[T]) ReadBinary(r io.Reader) error {
+	var decoded LinkedList[T]
+	err := codec.Decode(r, func(int) {}, decoded.AddLast)
+	if err == nil {
+		*list = decoded
+	}
+	return err
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (list LinkedList[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := list.WriteBinary(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (list *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	return list.ReadBinary(bytes.NewReader(data))
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (list LinkedList[T]) GobEncode() ([]byte, error) {
+	return list.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (list *LinkedList[T]) GobDecode(data []byte) error {
+	return list.UnmarshalBinary(data)
+}
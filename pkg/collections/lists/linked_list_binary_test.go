@@ -0,0 +1,77 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+type binaryTestStruct struct {
+	Name  string
+	Value int
+}
+
+func TestLinkedList_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		list *LinkedList[binaryTestStruct]
+	}{
+		{"empty", NewLinkedList[binaryTestStruct]()},
+		{"values", NewLinkedListItems(binaryTestStruct{"a", 1}, binaryTestStruct{}, binaryTestStruct{"c", 3})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.list.MarshalBinary()
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			decoded := NewLinkedListItems(binaryTestStruct{"old", 0})
+			if err = decoded.UnmarshalBinary(data); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if actual := decoded.ToArray(); !reflect.DeepEqual(actual, tt.list.ToArray()) {
+				t.Fatalf("UnmarshalBinary() got: %v, want: %v", actual, tt.list.ToArray())
+			}
+			checkListLinks(t, decoded)
+		})
+	}
+}
+
+func TestLinkedList_UnmarshalBinary_fail(t *testing.T) {
+	data, _ := NewLinkedListItems(1, 2, 3).MarshalBinary()
+	list := NewLinkedListItems(7)
+	if err := list.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{7}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
+
+func TestLinkedList_Gob(t *testing.T) {
+	type snapshot struct {
+		Items *LinkedList[string]
+		Value LinkedList[int]
+	}
+	var buf bytes.Buffer
+	value := snapshot{Items: NewLinkedListItems("c", "a", "b"), Value: *NewLinkedListItems(3, 1, 2)}
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var decoded snapshot
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := decoded.Items.ToArray(); !reflect.DeepEqual(actual, []string{"c", "a", "b"}) {
+		t.Fatalf("gob got: %v, want: %v", actual, []string{"c", "a", "b"})
+	}
+	if actual := decoded.Value.ToArray(); !reflect.DeepEqual(actual, []int{3, 1, 2}) {
+		t.Fatalf("gob got: %v, want: %v", actual, []int{3, 1, 2})
+	}
+	checkListLinks(t, &decoded.Value)
+}
@@ -0,0 +1,45 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// String implements the fmt.Stringer interface.
+// Returns the elements of the list in square brackets (from the first to the last element), e.g. '[1 2 3]'.
+func (list LinkedList[T]) String() string {
+	return fmt.Sprint(list.ToArray())
+}
+
+// Format implements the fmt.Formatter interface.
+//   - %v - the elements of the list, e.g. '[1 2 3]'
+//   - %+v - the size of the list followed by its elements
+//   - %#v - Go-syntax representation, e.g. 'lists.NewLinkedListItems[int](1, 2, 3)'
+//   - other verbs are applied to the elements, e.g. %q or %x
+func (list LinkedList[T]) Format(f fmt.State, verb rune) {
+	codec.Format(f, verb, "lists.NewLinkedListItems", fmt.Sprintf("size: %d", list.size), list.ToArray())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The list is encoded as a comma-separated (CSV) list of its elements (from the first to the last element).
+// The elements must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func (list LinkedList[T]) MarshalText() ([]byte, error) {
+	return codec.MarshalText(list.Values())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The list is decoded from a comma-separated (CSV) list of elements, replacing the current contents of the list.
+// The list is not changed if an error is returned.
+func (list *LinkedList[T]) UnmarshalText(text []byte) error {
+	var decoded LinkedList[T]
+	if err := codec.UnmarshalText(text, decoded.AddLast); err != nil {
+		return err
+	}
+	*list = decoded
+	return nil
+}
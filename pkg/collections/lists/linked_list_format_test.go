@@ -0,0 +1,113 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedList_Format(t *testing.T) {
+	list := NewLinkedListItems("a", "b")
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "[a b]"},
+		{"%s", "[a b]"},
+		{"%q", `["a" "b"]`},
+		{"%+v", "{size: 2, elements: [a b]}"},
+		{"%#v", `lists.NewLinkedListItems[string]("a", "b")`},
+		{"%5s", "[    a     b]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if actual := fmt.Sprintf(tt.format, list); actual != tt.want {
+				t.Errorf("Sprintf(%s) got: '%s', want: '%s'", tt.format, actual, tt.want)
+			}
+		})
+	}
+	if actual := list.String(); actual != "[a b]" {
+		t.Fatalf("String() got: '%s', want: '%s'", actual, "[a b]")
+	}
+	if actual := fmt.Sprintf("%+v", NewLinkedListItems(listTestStruct{"a", 1})); actual !=
+		"{size: 1, elements: [{name:a value:1}]}" {
+		t.Fatalf("Sprintf(%%+v) got: '%s'", actual)
+	}
+}
+
+func TestLinkedList_Format_value(t *testing.T) {
+	type holder struct {
+		Name string
+		List LinkedList[int]
+	}
+	value := holder{Name: "h"}
+	value.List.AddLast(1)
+	value.List.AddLast(2)
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", value.List, "[1 2]"},
+		{"%v", value, "{h [1 2]}"},
+		{"%+v", value, "{Name:h List:{size: 2, elements: [1 2]}}"},
+		{"%v", &value.List, "[1 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if actual := fmt.Sprintf(tt.format, tt.arg); actual != tt.want {
+				t.Errorf("Sprintf(%s) got: '%s', want: '%s'", tt.format, actual, tt.want)
+			}
+		})
+	}
+	var stringer fmt.Stringer = value.List
+	if actual := stringer.String(); actual != "[1 2]" {
+		t.Fatalf("String() got: '%s', want: '%s'", actual, "[1 2]")
+	}
+}
+
+func TestLinkedList_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		list *LinkedList[float64]
+		want string
+	}{
+		{"empty", NewLinkedList[float64](), ""},
+		{"single", NewLinkedListItems(1.5), "1.5"},
+		{"values", NewLinkedListItems(3, 1.25, -2), "3,1.25,-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.list.MarshalText()
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() got: '%s', want: '%s'", text, tt.want)
+			}
+			decoded := NewLinkedListItems[float64](100)
+			if err = decoded.UnmarshalText(text); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if !reflect.DeepEqual(decoded.ToArray(), tt.list.ToArray()) {
+				t.Errorf("UnmarshalText() got: %v, want: %v", decoded, tt.list)
+			}
+		})
+	}
+}
+
+func TestLinkedList_UnmarshalText_fail(t *testing.T) {
+	list := NewLinkedListItems(true)
+	for _, text := range []string{"true,maybe", "a\nb", `"a`} {
+		if err := list.UnmarshalText([]byte(text)); err == nil {
+			t.Fatalf("an error is expected for '%s'", text)
+		}
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []bool{true}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
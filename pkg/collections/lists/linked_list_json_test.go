@@ -0,0 +1,83 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedList_JSON(t *testing.T) {
+	type payload struct {
+		Items *LinkedList[string] `json:"items"`
+		Empty *LinkedList[int]    `json:"empty"`
+	}
+	data, err := json.Marshal(payload{Items: NewLinkedListItems("c", "a", "b"), Empty: NewLinkedList[int]()})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	const want = `{"items":["c","a","b"],"empty":[]}`
+	if string(data) != want {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, want)
+	}
+	var decoded payload
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := decoded.Items.ToArray(); !reflect.DeepEqual(actual, []string{"c", "a", "b"}) {
+		t.Fatalf("UnmarshalJSON() got: %v, want: %v", actual, []string{"c", "a", "b"})
+	}
+	if decoded.Empty.Size() != 0 {
+		t.Fatalf("UnmarshalJSON() got: %v, want empty list", decoded.Empty.ToArray())
+	}
+	checkListLinks(t, decoded.Items)
+}
+
+func TestLinkedList_JSON_value(t *testing.T) {
+	type payload struct {
+		Items LinkedList[int] `json:"items"`
+	}
+	var value payload
+	value.Items.AddLast(1)
+	value.Items.AddLast(2)
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	const want = `{"items":[1,2]}`
+	if string(data) != want {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, want)
+	}
+	var decoded payload
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := decoded.Items.ToArray(); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Fatalf("UnmarshalJSON() got: %v, want: %v", actual, []int{1, 2})
+	}
+}
+
+func TestLinkedList_UnmarshalJSON(t *testing.T) {
+	list := NewLinkedListItems(1, 2)
+	if err := json.Unmarshal([]byte("[3,4,3]"), list); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{3, 4, 3}) {
+		t.Fatalf("UnmarshalJSON() got: %v, want: %v", actual, []int{3, 4, 3})
+	}
+	if err := json.Unmarshal([]byte(`{"a":1}`), list); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []int{3, 4, 3}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+	if err := json.Unmarshal([]byte("null"), list); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if list.Size() != 0 {
+		t.Fatalf("the list must be empty: %v", list.ToArray())
+	}
+}
@@ -604,6 +604,139 @@ func TestLinkedList_Get_fail(t *testing.T) {
 	}
 }
 
+func TestLinkedList_Get_backward(t *testing.T) {
+	const size = 11
+	list := NewLinkedList[int]()
+	for i := 0; i < size; i++ {
+		list.AddLast(i)
+	}
+	for i := size - 1; i >= 0; i-- {
+		actual, err := list.Get(i)
+		if err != nil {
+			t.Fatal("unexpected error:", err, "i:", i)
+		}
+		if actual != i {
+			t.Fatalf("unexpected value: %d, want: %d", actual, i)
+		}
+	}
+	if _, err := list.Get(size); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrIndexOutOfRange, err)
+	}
+}
+
+func TestLinkedList_Set(t *testing.T) {
+	list := NewLinkedListItems[string]("one", "two", "three")
+	old, err := list.Set(1, "2")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if old != "two" {
+		t.Fatalf("unexpected old value: '%s', want: '%s'", old, "two")
+	}
+	old, err = list.Set(2, "3")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if old != "three" {
+		t.Fatalf("unexpected old value: '%s', want: '%s'", old, "three")
+	}
+	want := []string{"one", "2", "3"}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Set() got: %v, want: %v", actual, want)
+	}
+}
+func TestLinkedList_Set_fail(t *testing.T) {
+	list := NewLinkedListItems[string]("one")
+	for _, index := range []int{-1, 1} {
+		old, err := list.Set(index, "value")
+		if !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("expected error: '%v', got: '%v'", ErrIndexOutOfRange, err)
+		}
+		if old != "" {
+			t.Fatalf("expected: '', actual: '%s'", old)
+		}
+	}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, []string{"one"}) {
+		t.Fatalf("the list was changed: %v", actual)
+	}
+}
+
+func TestLinkedList_Insert(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      *LinkedList[int]
+		index     int
+		value     int
+		wantArray []int
+	}{
+		{"empty", NewLinkedList[int](), 0, 1, []int{1}},
+		{"first", NewLinkedListItems[int](2, 3), 0, 1, []int{1, 2, 3}},
+		{"last", NewLinkedListItems[int](1, 2), 2, 3, []int{1, 2, 3}},
+		{"middle", NewLinkedListItems[int](1, 2, 4, 5), 2, 3, []int{1, 2, 3, 4, 5}},
+		{"before last", NewLinkedListItems[int](1, 2, 4), 2, 3, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.list.Insert(tt.index, tt.value); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			actual := tt.list.ToArray()
+			if !reflect.DeepEqual(actual, tt.wantArray) {
+				t.Errorf("Insert() got: %v, want: %v", actual, tt.wantArray)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
+func TestLinkedList_Insert_fail(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2)
+	for _, index := range []int{-1, 3} {
+		if err := list.Insert(index, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("expected error: '%v', got: '%v'", ErrIndexOutOfRange, err)
+		}
+	}
+	if list.Size() != 2 {
+		t.Fatalf("unexpected list size: %d, want: %d", list.Size(), 2)
+	}
+}
+
+func TestLinkedList_InsertAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      *LinkedList[int]
+		index     int
+		values    []int
+		wantArray []int
+	}{
+		{"empty", NewLinkedList[int](), 0, []int{1, 2}, []int{1, 2}},
+		{"no values", NewLinkedListItems[int](1, 2), 1, nil, []int{1, 2}},
+		{"first", NewLinkedListItems[int](3), 0, []int{1, 2}, []int{1, 2, 3}},
+		{"last", NewLinkedListItems[int](1), 1, []int{2, 3}, []int{1, 2, 3}},
+		{"middle", NewLinkedListItems[int](1, 5), 1, []int{2, 3, 4}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.list.InsertAll(tt.index, tt.values...); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			actual := tt.list.ToArray()
+			if !reflect.DeepEqual(actual, tt.wantArray) {
+				t.Errorf("InsertAll() got: %v, want: %v", actual, tt.wantArray)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
+func TestLinkedList_InsertAll_fail(t *testing.T) {
+	list := NewLinkedList[int]()
+	if err := list.InsertAll(1, 1, 2); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrIndexOutOfRange, err)
+	}
+	if list.Size() != 0 {
+		t.Fatalf("unexpected list size: %d, want: %d", list.Size(), 0)
+	}
+}
+
 func TestLinkedList_ToArray_empty(t *testing.T) {
 	list := NewLinkedList[int]()
 	actual := list.ToArray()
@@ -631,6 +764,25 @@ func TestLinkedList_ToArray(t *testing.T) {
 	}
 }
 
+func TestLinkedList_ToSlice(t *testing.T) {
+	list := NewLinkedList[int]()
+	if !list.IsEmpty() || len(list.ToSlice()) != 0 {
+		t.Fatal("the list isn't empty")
+	}
+	list.AddLast(1)
+	list.AddLast(2)
+	if list.IsEmpty() {
+		t.Fatal("the list is empty")
+	}
+	if actual := list.ToSlice(); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Fatalf("ToSlice() got: %v, want: %v", actual, []int{1, 2})
+	}
+	list.Clear()
+	if !list.IsEmpty() {
+		t.Fatal("the list isn't empty after Clear()")
+	}
+}
+
 func TestLinkedList_AddLast(t *testing.T) {
 	list := NewLinkedList[int]()
 	list.AddLast(1)
@@ -784,7 +936,94 @@ func TestNewLinkedList(t *testing.T) {
 	}
 }
 
+func TestLinkedList_All(t *testing.T) {
+	list := NewLinkedListItems[int](10, 20, 30, 40)
+	var indexes, values []int
+	for i, v := range list.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2, 3}) {
+		t.Fatalf("All() indexes got: %v, want: %v", indexes, []int{0, 1, 2, 3})
+	}
+	if !reflect.DeepEqual(values, list.ToArray()) {
+		t.Fatalf("All() values got: %v, want: %v", values, list.ToArray())
+	}
+}
+func TestLinkedList_All_break(t *testing.T) {
+	list := NewLinkedListItems[int](1, 2, 3, 4, 5)
+	var values []int
+	for _, v := range list.All() {
+		if v > 2 {
+			break
+		}
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Fatalf("All() got: %v, want: %v", values, []int{1, 2})
+	}
+}
+func TestLinkedList_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		list *LinkedList[string]
+		want []string
+	}{
+		{"empty", NewLinkedList[string](), nil},
+		{"single", NewLinkedListItems[string]("one"), []string{"one"}},
+		{"three", NewLinkedListItems[string]("one", "two", "three"), []string{"one", "two", "three"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for v := range tt.list.Values() {
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+func TestLinkedList_Backward(t *testing.T) {
+	list := NewLinkedListItems[int](10, 20, 30)
+	var indexes, values []int
+	for i, v := range list.Backward() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+		if v == 20 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(indexes, []int{2, 1}) {
+		t.Fatalf("Backward() indexes got: %v, want: %v", indexes, []int{2, 1})
+	}
+	if !reflect.DeepEqual(values, []int{30, 20}) {
+		t.Fatalf("Backward() values got: %v, want: %v", values, []int{30, 20})
+	}
+}
+
 type listTestStruct struct {
 	name  string
 	value int
 }
+
+func TestLinkedList_Sort_sorted(t *testing.T) {
+	const size = 1 << 12
+	list := NewLinkedList[int]()
+	for i := 0; i < size; i++ {
+		list.AddLast(i)
+	}
+	var comparisons int
+	list.Sort(func(a, b int) bool {
+		comparisons++
+		return a > b
+	})
+	if first, _ := list.GetFirst(); first != size-1 {
+		t.Fatalf("Sort() got first: %d, want: %d", first, size-1)
+	}
+	checkListLinks(t, list)
+	if limit := size * 12; comparisons > limit {
+		t.Fatalf("Sort() comparisons got: %d, want: <= %d", comparisons, limit)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+// SortListStable sorts the list according to the order specified by the less function,
+// keeping the original order of equal elements.
+// It uses a bottom-up merge sort that relinks list items instead of swapping their values,
+// so it takes O(n*log(n)) time and O(1) additional space regardless of the initial order of the elements.
+//   - less - the function used to compare list elements
+func SortListStable[T any](list *LinkedList[T], less func(item1, item2 T) bool) {
+	if list.size < 2 {
+		return
+	}
+	head := list.first
+	for width := 1; width < list.size; width *= 2 {
+		var newHead, tail *listItem[T]
+		for left := head; left != nil; {
+			right := splitItems(left, width)
+			next := splitItems(right, width)
+			merged, mergedTail := mergeItems(left, right, less)
+			if tail == nil {
+				newHead = merged
+			} else {
+				tail.next = merged
+			}
+			tail = mergedTail
+			left = next
+		}
+		head = newHead
+	}
+	var prev *listItem[T]
+	for item := head; item != nil; item = item.next {
+		item.prev = prev
+		prev = item
+	}
+	list.first, list.last = head, prev
+}
+
+// IsSorted returns true if the list is sorted according to the order specified by the less function.
+//   - less - the function used to compare list elements
+func IsSorted[T any](list *LinkedList[T], less func(item1, item2 T) bool) bool {
+	for item := list.first; item != nil && item.next != nil; item = item.next {
+		if less(item.next.value, item.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitItems cuts the chain of items after the specified number of items and returns the rest of the chain.
+func splitItems[T any](start *listItem[T], count int) *listItem[T] {
+	for i := 1; start != nil && i < count; i++ {
+		start = start.next
+	}
+	if start == nil {
+		return nil
+	}
+	rest := start.next
+	start.next = nil
+	return rest
+}
+
+// mergeItems merges two sorted chains of items linked by the 'next' field and returns the head and the tail
+// of the merged chain. Items of the left chain precede equal items of the right chain.
+func mergeItems[T any](left, right *listItem[T], less func(item1, item2 T) bool) (*listItem[T], *listItem[T]) {
+	var head, tail *listItem[T]
+	for left != nil || right != nil {
+		var item *listItem[T]
+		if right == nil || (left != nil && !less(right.value, left.value)) {
+			item, left = left, left.next
+		} else {
+			item, right = right, right.next
+		}
+		if tail == nil {
+			head = item
+		} else {
+			tail.next = item
+		}
+		tail = item
+	}
+	return head, tail
+}
@@ -0,0 +1,93 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func BenchmarkSortListStable_int(b *testing.B) {
+	benchmarks := []struct {
+		want []int
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+	list := NewLinkedList[int]()
+	fillList := func(array []int) {
+		for _, val := range array {
+			list.AddLast(val)
+		}
+	}
+	less := func(v1, v2 int) bool { return v1 < v2 }
+	for _, bm := range benchmarks {
+		b.Run(fmt.Sprint(bm.want), func(b *testing.B) {
+			iterator := circleLeftShiftIterator(bm.want)
+			b.ResetTimer()
+			b.StopTimer()
+			for i := 0; i < b.N; i++ {
+				list.Clear()
+				fillList(iterator())
+				b.StartTimer()
+
+				SortListStable(list, less)
+
+				b.StopTimer()
+				actual := list.ToArray()
+				if !reflect.DeepEqual(actual, bm.want) {
+					b.Fatalf("SortListStable() %d. got: %v; want: %v", i, actual, bm.want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSortList_order(b *testing.B) {
+	const size = 2000
+	sorted := make([]int, size)
+	reversed := make([]int, size)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = size - i
+	}
+	random := rand.New(rand.NewPCG(1, 2)).Perm(size)
+	inputs := []struct {
+		name   string
+		values []int
+	}{
+		{"random", random},
+		{"sorted", sorted},
+		{"reversed", reversed},
+	}
+	sorts := []struct {
+		name string
+		sort func(list *LinkedList[int], less func(item1, item2 int) bool)
+	}{
+		{"SortList", SortList[int]},
+		{"SortListStable", SortListStable[int]},
+	}
+	less := func(v1, v2 int) bool { return v1 < v2 }
+	for _, input := range inputs {
+		for _, srt := range sorts {
+			b.Run(fmt.Sprintf("%s %s %d", srt.name, input.name, size), func(b *testing.B) {
+				b.StopTimer()
+				for i := 0; i < b.N; i++ {
+					list := NewLinkedListItems[int](input.values...)
+					b.StartTimer()
+
+					srt.sort(list, less)
+
+					b.StopTimer()
+					if !IsSorted(list, less) {
+						b.Fatalf("%s() the list is not sorted", srt.name)
+					}
+				}
+			})
+		}
+	}
+}
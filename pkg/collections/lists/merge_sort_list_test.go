@@ -0,0 +1,107 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSortListStable(t *testing.T) {
+	less := func(val1, val2 int) bool { return val1 < val2 }
+	tests := []struct {
+		list *LinkedList[int]
+		want []int
+	}{
+		{NewLinkedList[int](), []int{}},
+		{NewLinkedListItems[int](1), []int{1}},
+		{NewLinkedListItems[int](3, 1), []int{1, 3}},
+		{NewLinkedListItems[int](1, 2, 3), []int{1, 2, 3}},
+		{NewLinkedListItems[int](3, 2, 1), []int{1, 2, 3}},
+		{NewLinkedListItems[int](5, 1, 4, 2, 3), []int{1, 2, 3, 4, 5}},
+		{NewLinkedListItems[int](2, 2, 1, 1, 3, 3, 0), []int{0, 1, 1, 2, 2, 3, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.list.ToArray()), func(t *testing.T) {
+			SortListStable(tt.list, less)
+			actual := tt.list.ToArray()
+			if !reflect.DeepEqual(actual, tt.want) {
+				t.Errorf("SortListStable() got: %v, want: %v", actual, tt.want)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
+
+func TestSortListStable_stability(t *testing.T) {
+	list := NewLinkedList[listTestStruct]()
+	for i := 0; i < 20; i++ {
+		list.AddLast(listTestStruct{name: fmt.Sprint(i % 3), value: i})
+	}
+	SortListStable(list, func(item1, item2 listTestStruct) bool { return item1.name < item2.name })
+	prev := listTestStruct{value: -1}
+	for _, item := range list.All() {
+		if item.name == prev.name && item.value < prev.value {
+			t.Fatalf("the order of equal elements was changed: %v", list.ToArray())
+		}
+		prev = item
+	}
+	checkListLinks(t, list)
+}
+
+func TestSortListStable_int_big(t *testing.T) {
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	shuffle := circleLeftShiftIterator(expected)
+	less := func(val1, val2 int) bool { return val1 < val2 }
+	count := 2 * 3 * 4 * 5 * 6 * 7 * 8
+	for i := 0; i < count; i++ {
+		list := NewLinkedListItems[int](shuffle()...)
+		SortListStable(list, less)
+		actual := list.ToArray()
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("SortListStable() got: %v, want: %v", actual, expected)
+		}
+	}
+}
+
+func TestSortListStable_large_sorted(t *testing.T) {
+	const size = 100_000
+	list := NewLinkedList[int]()
+	for i := size; i > 0; i-- {
+		list.AddFirst(i)
+	}
+	less := func(val1, val2 int) bool { return val1 < val2 }
+	SortListStable(list, less)
+	if !IsSorted(list, less) {
+		t.Fatal("the list is not sorted")
+	}
+	SortListStable(list, func(val1, val2 int) bool { return val1 > val2 })
+	if first, _ := list.GetFirst(); first != size {
+		t.Fatalf("unexpected first value: %d, want: %d", first, size)
+	}
+	checkListLinks(t, list)
+}
+
+func TestIsSorted(t *testing.T) {
+	less := func(val1, val2 int) bool { return val1 < val2 }
+	tests := []struct {
+		list *LinkedList[int]
+		want bool
+	}{
+		{NewLinkedList[int](), true},
+		{NewLinkedListItems[int](1), true},
+		{NewLinkedListItems[int](1, 1, 2), true},
+		{NewLinkedListItems[int](2, 1), false},
+		{NewLinkedListItems[int](1, 2, 3, 5, 4), false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.list.ToArray()), func(t *testing.T) {
+			if got := IsSorted(tt.list, less); got != tt.want {
+				t.Errorf("IsSorted() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
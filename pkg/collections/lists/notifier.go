@@ -0,0 +1,47 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"context"
+	"sync"
+)
+
+// notifier lets goroutines wait for a change of a structure guarded by a mutex, with a context to cancel waiting.
+// All its methods must be called while the mutex is held. The zero value is ready to use.
+type notifier struct {
+	changed chan struct{}
+	waiters int
+}
+
+// notify wakes up all waiting goroutines. It does nothing if there are no waiting goroutines.
+func (n *notifier) notify() {
+	if n.waiters > 0 {
+		close(n.changed)
+		n.changed = nil
+		n.waiters = 0
+	}
+}
+
+// wait releases the mutex, waits until notify is called or the context is done and acquires the mutex again.
+func (n *notifier) wait(ctx context.Context, mu sync.Locker) error {
+	if n.changed == nil {
+		n.changed = make(chan struct{})
+	}
+	changed := n.changed
+	n.waiters++
+	mu.Unlock()
+	var err error
+	select {
+	case <-changed:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	mu.Lock()
+	if err != nil && n.changed == changed {
+		n.waiters--
+	}
+	return err
+}
@@ -0,0 +1,165 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import "cmp"
+
+// PriorityQueue is a queue based on a binary heap that retrieves its elements in the order
+// specified by the less function: Pop always returns the least element.
+// Push and Pop take O(log n) time, Peek takes O(1) time.
+// PriorityQueue is not thread safe and not intended for concurrent usage.
+//   - T - value type
+type PriorityQueue[T any] struct {
+	values []T
+	less   func(item1, item2 T) bool
+}
+
+func (pq *PriorityQueue[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.less(pq.values[index], pq.values[parent]) {
+			return
+		}
+		pq.values[index], pq.values[parent] = pq.values[parent], pq.values[index]
+		index = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) down(index int) {
+	size := len(pq.values)
+	for {
+		least := index
+		if left := 2*index + 1; left < size && pq.less(pq.values[left], pq.values[least]) {
+			least = left
+		}
+		if right := 2*index + 2; right < size && pq.less(pq.values[right], pq.values[least]) {
+			least = right
+		}
+		if least == index {
+			return
+		}
+		pq.values[index], pq.values[least] = pq.values[least], pq.values[index]
+		index = least
+	}
+}
+
+func (pq *PriorityQueue[T]) heapify() {
+	for i := len(pq.values)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+}
+
+// Push adds the value to the queue.
+//   - value - the value to be added
+func (pq *PriorityQueue[T]) Push(value T) {
+	pq.values = append(pq.values, value)
+	pq.up(len(pq.values) - 1)
+}
+
+// PushAll adds all the specified values to the queue.
+// If the number of values is comparable to the size of the queue, the heap is rebuilt in O(n) time,
+// which is faster than pushing the values one by one.
+//   - values - the values to be added
+func (pq *PriorityQueue[T]) PushAll(values ...T) {
+	if len(values) < len(pq.values)/2 {
+		for _, value := range values {
+			pq.Push(value)
+		}
+		return
+	}
+	pq.values = append(pq.values, values...)
+	pq.heapify()
+}
+
+// Pop removes the least element from the queue and returns its value and true if it exists.
+// If the queue is empty, a default value of type T and false is returned.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	last := len(pq.values) - 1
+	if last < 0 {
+		return zero, false
+	}
+	res := pq.values[0]
+	pq.values[0], pq.values[last] = pq.values[last], zero
+	pq.values = pq.values[:last]
+	pq.down(0)
+	return res, true
+}
+
+// Peek returns the least element of the queue without removing it and true if it exists.
+// If the queue is empty, this method returns a default value of type T and false.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.values) > 0 {
+		return pq.values[0], true
+	}
+	var res T
+	return res, false
+}
+
+// Len returns the number of elements in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.values)
+}
+
+// IsEmpty returns true if the queue does not contain any elements.
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.values) == 0
+}
+
+// Clear removes all the elements from the queue.
+func (pq *PriorityQueue[T]) Clear() {
+	clear(pq.values)
+	pq.values = pq.values[:0]
+}
+
+// ToArray returns an array containing all elements of the queue in an unspecified order.
+func (pq *PriorityQueue[T]) ToArray() []T {
+	result := make([]T, len(pq.values))
+	copy(result, pq.values)
+	return result
+}
+
+// ToSortedSlice returns a slice containing all elements of the queue in the order they would be retrieved by Pop.
+// The queue is not changed.
+func (pq *PriorityQueue[T]) ToSortedSlice() []T {
+	heap := PriorityQueue[T]{values: pq.ToArray(), less: pq.less}
+	result := make([]T, 0, len(heap.values))
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// NewPriorityQueue constructs an empty queue that orders its elements by the less function.
+//   - less - the function used to compare queue elements; Pop returns the least element
+func NewPriorityQueue[T any](less func(item1, item2 T) bool) *PriorityQueue[T] {
+	return NewPriorityQueueCapacity(less, 0)
+}
+
+// NewPriorityQueueCapacity constructs an empty queue with the specified initial capacity.
+//   - less - the function used to compare queue elements; Pop returns the least element
+//   - capacity - initial space size
+func NewPriorityQueueCapacity[T any](less func(item1, item2 T) bool, capacity int) *PriorityQueue[T] {
+	return &PriorityQueue[T]{values: make([]T, 0, max(capacity, 0)), less: less}
+}
+
+// NewPriorityQueueItems constructs a queue containing the specified values.
+//   - less - the function used to compare queue elements; Pop returns the least element
+//   - values ...T - values that the queue will contain
+func NewPriorityQueueItems[T any](less func(item1, item2 T) bool, values ...T) *PriorityQueue[T] {
+	result := NewPriorityQueueCapacity(less, len(values))
+	result.PushAll(values...)
+	return result
+}
+
+// NewMinPriorityQueue constructs an empty queue of ordered values that retrieves the smallest value first.
+func NewMinPriorityQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(cmp.Less[T])
+}
+
+// NewMaxPriorityQueue constructs an empty queue of ordered values that retrieves the largest value first.
+func NewMaxPriorityQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(func(item1, item2 T) bool { return cmp.Less(item2, item1) })
+}
@@ -0,0 +1,127 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkHeap checks that every element of the queue is not less than its parent.
+func checkHeap[T any](t *testing.T, pq *PriorityQueue[T]) {
+	t.Helper()
+	for i := 1; i < len(pq.values); i++ {
+		if pq.less(pq.values[i], pq.values[(i-1)/2]) {
+			t.Fatalf("the heap property is violated at %d: %v", i, pq.values)
+		}
+	}
+}
+
+func TestNewPriorityQueue(t *testing.T) {
+	pq := NewMinPriorityQueue[int]()
+	if pq.Len() != 0 || !pq.IsEmpty() {
+		t.Fatalf("invalid length: %d", pq.Len())
+	}
+	if _, ok := pq.Peek(); ok {
+		t.Fatal("Peek() returned an element of an empty queue")
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Fatal("Pop() returned an element of an empty queue")
+	}
+	if actual := pq.ToSortedSlice(); actual == nil || len(actual) != 0 {
+		t.Fatal("an empty slice is expected")
+	}
+}
+
+func TestPriorityQueue_Push_Pop(t *testing.T) {
+	type testCase struct {
+		name string
+		pq   *PriorityQueue[int]
+		want []int
+	}
+	values := []int{5, 1, 4, 1, 3, 9, 2, 6}
+	tests := []testCase{
+		{"min", NewMinPriorityQueue[int](), []int{1, 1, 2, 3, 4, 5, 6, 9}},
+		{"max", NewMaxPriorityQueue[int](), []int{9, 6, 5, 4, 3, 2, 1, 1}},
+		{"less", NewPriorityQueue(func(a, b int) bool { return a%3 < b%3 || (a%3 == b%3 && a < b) }),
+			[]int{3, 6, 9, 1, 1, 4, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range values {
+				tt.pq.Push(v)
+				checkHeap(t, tt.pq)
+			}
+			if v, ok := tt.pq.Peek(); !ok || v != tt.want[0] {
+				t.Fatalf("Peek() got: %d, %t, want: %d, true", v, ok, tt.want[0])
+			}
+			if actual := tt.pq.ToSortedSlice(); !reflect.DeepEqual(actual, tt.want) {
+				t.Fatalf("ToSortedSlice() got: %v, want: %v", actual, tt.want)
+			}
+			if tt.pq.Len() != len(values) {
+				t.Fatalf("ToSortedSlice() changed the queue, length: %d", tt.pq.Len())
+			}
+			var actual []int
+			for v, ok := tt.pq.Pop(); ok; v, ok = tt.pq.Pop() {
+				checkHeap(t, tt.pq)
+				actual = append(actual, v)
+			}
+			if !reflect.DeepEqual(actual, tt.want) {
+				t.Fatalf("Pop() got: %v, want: %v", actual, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_PushAll(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	values := rnd.Perm(1000)
+	pq := NewPriorityQueueItems(func(a, b int) bool { return a < b }, values[:100]...)
+	checkHeap(t, pq)
+	pq.Push(values[100])
+	pq.PushAll(values[101:120]...)
+	checkHeap(t, pq)
+	pq.PushAll(values[120:]...)
+	checkHeap(t, pq)
+	if pq.Len() != len(values) {
+		t.Fatalf("invalid length, expected: %d, actual: %d", len(values), pq.Len())
+	}
+	want := slices.Sorted(slices.Values(values))
+	if actual := pq.ToSortedSlice(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("ToSortedSlice() got: %v", actual)
+	}
+	if actual := slices.Sorted(slices.Values(pq.ToArray())); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("ToArray() got: %v", actual)
+	}
+	pq.Clear()
+	if !pq.IsEmpty() {
+		t.Fatal("the queue was not cleared")
+	}
+	pq.PushAll()
+	pq.Push(7)
+	if v, _ := pq.Peek(); v != 7 {
+		t.Fatalf("Peek() got: %d, want: %d", v, 7)
+	}
+}
+
+func BenchmarkPriorityQueue_PushAll(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(10000)
+	less := func(a, b int) bool { return a < b }
+	b.Run("PushAll", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewPriorityQueueCapacity(less, len(values)).PushAll(values...)
+		}
+	})
+	b.Run("Push", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pq := NewPriorityQueueCapacity(less, len(values))
+			for _, v := range values {
+				pq.Push(v)
+			}
+		}
+	})
+}
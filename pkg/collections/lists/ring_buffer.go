@@ -0,0 +1,186 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+var (
+	// ErrBufferFull error: 'buffer is full'
+	ErrBufferFull = errors.New("buffer is full")
+)
+
+// OverflowPolicy determines what RingBuffer.Push does when the buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowOverwrite overwrites the oldest element of the buffer.
+	OverflowOverwrite OverflowPolicy = iota
+	// OverflowReject rejects the new element and returns ErrBufferFull.
+	OverflowReject
+	// OverflowBlock waits until an element is removed from the buffer.
+	OverflowBlock
+)
+
+// RingBuffer is a thread safe fixed-capacity circular buffer.
+// When the buffer is full, a new element is handled according to the overflow policy of the buffer.
+// The zero value of RingBuffer is an empty buffer with capacity 1 and the overwrite policy.
+//   - T - value type
+type RingBuffer[T any] struct {
+	mu      sync.Mutex
+	values  []T
+	head    int
+	size    int
+	policy  OverflowPolicy
+	changed notifier
+}
+
+// buffer returns the storage of the buffer, allocating it for the zero value. The mutex must be held.
+func (rb *RingBuffer[T]) buffer() []T {
+	if rb.values == nil {
+		rb.values = make([]T, 1)
+	}
+	return rb.values
+}
+
+func (rb *RingBuffer[T]) index(index int) int {
+	return (rb.head + index) % len(rb.values)
+}
+
+// Push adds the value to the buffer as the newest element.
+// If the buffer is full, the overwrite policy replaces the oldest element, the reject policy returns ErrBufferFull
+// and the block policy waits until an element is removed.
+//   - value - the value to be added
+func (rb *RingBuffer[T]) Push(value T) error {
+	return rb.PushContext(context.Background(), value)
+}
+
+// PushContext adds the value to the buffer like Push, but returns the context error
+// if the context is done before the value was added by the block policy.
+//   - ctx - the context that cancels waiting
+//   - value - the value to be added
+func (rb *RingBuffer[T]) PushContext(ctx context.Context, value T) error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	for rb.size == len(rb.buffer()) {
+		switch rb.policy {
+		case OverflowOverwrite:
+			rb.values[rb.head] = value
+			rb.head = rb.index(1)
+			rb.changed.notify()
+			return nil
+		case OverflowReject:
+			return ErrBufferFull
+		default:
+			if err := rb.changed.wait(ctx, &rb.mu); err != nil {
+				return err
+			}
+		}
+	}
+	rb.values[rb.index(rb.size)] = value
+	rb.size++
+	rb.changed.notify()
+	return nil
+}
+
+// Pop removes the oldest element from the buffer and returns its value and true if it exists.
+// If the buffer is empty, a default value of type T and false is returned.
+func (rb *RingBuffer[T]) Pop() (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	var res T
+	if rb.size == 0 {
+		return res, false
+	}
+	res, rb.values[rb.head] = rb.values[rb.head], res
+	rb.head = rb.index(1)
+	rb.size--
+	rb.changed.notify()
+	return res, true
+}
+
+// Peek returns the oldest element of the buffer without removing it and true if it exists.
+// If the buffer is empty, this method returns a default value of type T and false.
+func (rb *RingBuffer[T]) Peek() (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	if rb.size > 0 {
+		return rb.values[rb.head], true
+	}
+	var res T
+	return res, false
+}
+
+// Len returns the number of elements in the buffer.
+func (rb *RingBuffer[T]) Len() int {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.size
+}
+
+// Cap returns the maximum number of elements in the buffer.
+func (rb *RingBuffer[T]) Cap() int {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return len(rb.buffer())
+}
+
+// Policy returns the overflow policy of the buffer.
+func (rb *RingBuffer[T]) Policy() OverflowPolicy {
+	return rb.policy
+}
+
+// IsEmpty returns true if the buffer does not contain any elements.
+func (rb *RingBuffer[T]) IsEmpty() bool {
+	return rb.Len() == 0
+}
+
+// Clear removes all the elements from the buffer.
+func (rb *RingBuffer[T]) Clear() {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	clear(rb.values)
+	rb.head = 0
+	rb.size = 0
+	rb.changed.notify()
+}
+
+// ToArray returns an array containing all elements of the buffer from the oldest to the newest one.
+func (rb *RingBuffer[T]) ToArray() []T {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	result := make([]T, rb.size)
+	for i := range result {
+		result[i] = rb.values[rb.index(i)]
+	}
+	return result
+}
+
+// All returns an iterator over a snapshot of the buffer elements taken at the moment of the call,
+// from the oldest to the newest one, so the buffer can be modified during the iteration.
+func (rb *RingBuffer[T]) All() iter.Seq[T] {
+	snapshot := rb.ToArray()
+	return func(yield func(T) bool) {
+		for _, value := range snapshot {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// NewRingBuffer constructs an empty buffer that contains no more than the specified number of elements.
+//   - capacity - the maximum number of elements; if it is less than 1, the capacity is 1
+//   - policy - the overflow policy that determines what Push does when the buffer is full
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{values: make([]T, capacity), policy: policy}
+}
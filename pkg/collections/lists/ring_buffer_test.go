@@ -0,0 +1,175 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewRingBuffer(t *testing.T) {
+	rb := NewRingBuffer[int](0, OverflowReject)
+	if rb.Cap() != 1 || rb.Len() != 0 || !rb.IsEmpty() || rb.Policy() != OverflowReject {
+		t.Fatalf("invalid new buffer: capacity: %d, length: %d, policy: %d", rb.Cap(), rb.Len(), rb.Policy())
+	}
+	if _, ok := rb.Peek(); ok {
+		t.Fatal("Peek() returned an element of an empty buffer")
+	}
+	if _, ok := rb.Pop(); ok {
+		t.Fatal("Pop() returned an element of an empty buffer")
+	}
+}
+
+func TestRingBuffer_policy(t *testing.T) {
+	type testCase struct {
+		name    string
+		policy  OverflowPolicy
+		wantErr error
+		want    []int
+	}
+	tests := []testCase{
+		{"overwrite", OverflowOverwrite, nil, []int{3, 4, 5}},
+		{"reject", OverflowReject, ErrBufferFull, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := NewRingBuffer[int](3, tt.policy)
+			for i := 1; i <= 5; i++ {
+				err := rb.Push(i)
+				if i <= 3 && err != nil {
+					t.Fatalf("Push(%d) unexpected error: %v", i, err)
+				}
+				if i > 3 && !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Push(%d) error: %v, want: %v", i, err, tt.wantErr)
+				}
+			}
+			if actual := rb.ToArray(); !reflect.DeepEqual(actual, tt.want) {
+				t.Fatalf("ToArray() got: %v, want: %v", actual, tt.want)
+			}
+			if actual := slices.Collect(rb.All()); !reflect.DeepEqual(actual, tt.want) {
+				t.Fatalf("All() got: %v, want: %v", actual, tt.want)
+			}
+			if v, ok := rb.Peek(); !ok || v != tt.want[0] {
+				t.Fatalf("Peek() got: %d, %t, want: %d, true", v, ok, tt.want[0])
+			}
+			for _, want := range tt.want {
+				if v, ok := rb.Pop(); !ok || v != want {
+					t.Fatalf("Pop() got: %d, %t, want: %d, true", v, ok, want)
+				}
+			}
+			if !rb.IsEmpty() {
+				t.Fatal("the buffer isn't empty")
+			}
+		})
+	}
+}
+
+func TestRingBuffer_wrap(t *testing.T) {
+	rb := NewRingBuffer[string](3, OverflowReject)
+	for _, v := range []string{"a", "b", "c"} {
+		_ = rb.Push(v)
+	}
+	rb.Pop()
+	rb.Pop()
+	_ = rb.Push("d")
+	_ = rb.Push("e")
+	if actual := rb.ToArray(); !reflect.DeepEqual(actual, []string{"c", "d", "e"}) {
+		t.Fatalf("ToArray() got: %v, want: %v", actual, []string{"c", "d", "e"})
+	}
+	rb.Clear()
+	if !rb.IsEmpty() || len(rb.ToArray()) != 0 {
+		t.Fatal("the buffer was not cleared")
+	}
+	_ = rb.Push("f")
+	if v, _ := rb.Peek(); v != "f" {
+		t.Fatalf("Peek() got: %s, want: %s", v, "f")
+	}
+}
+
+func TestRingBuffer_block(t *testing.T) {
+	rb := NewRingBuffer[int](1, OverflowBlock)
+	_ = rb.Push(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := rb.PushContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("PushContext() error: %v, want: %v", err, context.DeadlineExceeded)
+	}
+	done := make(chan error)
+	go func() {
+		done <- rb.Push(3)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if v, _ := rb.Pop(); v != 1 {
+		t.Fatalf("Pop() got: %d, want: %d", v, 1)
+	}
+	if err := <-done; err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := rb.ToArray(); !reflect.DeepEqual(actual, []int{3}) {
+		t.Fatalf("ToArray() got: %v, want: %v", actual, []int{3})
+	}
+}
+
+func TestRingBuffer_concurrent(t *testing.T) {
+	const producers = 4
+	const amount = 500
+	rb := NewRingBuffer[int](8, OverflowBlock)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				if err := rb.Push(i); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	sum := 0
+	for received := 0; received < producers*amount; {
+		if v, ok := rb.Pop(); ok {
+			sum += v
+			received++
+		} else {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+	if want := producers * amount * (amount - 1) / 2; sum != want {
+		t.Fatalf("sum got: %d, want: %d", sum, want)
+	}
+}
+
+func TestRingBuffer_zero_value(t *testing.T) {
+	var rb RingBuffer[int]
+	if _, ok := rb.Pop(); ok {
+		t.Fatal("Pop() returned an element of an empty buffer")
+	}
+	if len(rb.ToArray()) != 0 || !rb.IsEmpty() {
+		t.Fatal("the buffer isn't empty")
+	}
+	if rb.Cap() != 1 || rb.Policy() != OverflowOverwrite {
+		t.Fatalf("invalid zero value buffer: capacity: %d, policy: %d", rb.Cap(), rb.Policy())
+	}
+	for i := 1; i <= 3; i++ {
+		if err := rb.Push(i); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+	if actual := rb.ToArray(); !reflect.DeepEqual(actual, []int{3}) {
+		t.Fatalf("ToArray() got: %v, want: %v", actual, []int{3})
+	}
+	if rb.changed.changed != nil {
+		t.Fatal("a channel was allocated although nobody is waiting")
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import "cmp"
+
+// SortListFunc sorts the list in ascending order as determined by the compare function.
+// The sort is stable, so it keeps the original order of equal elements.
+//   - compare - the function that returns a negative number when item1 < item2, a positive number
+//     when item1 > item2 and zero otherwise, such as cmp.Compare
+func SortListFunc[T any](list *LinkedList[T], compare func(item1, item2 T) int) {
+	SortListStable(list, func(item1, item2 T) bool { return compare(item1, item2) < 0 })
+}
+
+// SortListFuncReverse sorts the list in descending order as determined by the compare function.
+// The sort is stable, so it keeps the original order of equal elements.
+//   - compare - the function that returns a negative number when item1 < item2, a positive number
+//     when item1 > item2 and zero otherwise, such as cmp.Compare
+func SortListFuncReverse[T any](list *LinkedList[T], compare func(item1, item2 T) int) {
+	SortListFunc(list, ReverseCompare(compare))
+}
+
+// SortOrdered sorts the list of ordered values in ascending order.
+func SortOrdered[T cmp.Ordered](list *LinkedList[T]) {
+	SortListFunc(list, cmp.Compare[T])
+}
+
+// SortOrderedReverse sorts the list of ordered values in descending order.
+func SortOrderedReverse[T cmp.Ordered](list *LinkedList[T]) {
+	SortListFunc(list, ReverseCompare(cmp.Compare[T]))
+}
+
+// IsSortedFunc returns true if the list is sorted in ascending order as determined by the compare function.
+//   - compare - the function used to compare list elements
+func IsSortedFunc[T any](list *LinkedList[T], compare func(item1, item2 T) int) bool {
+	return IsSorted(list, func(item1, item2 T) bool { return compare(item1, item2) < 0 })
+}
+
+// ReverseCompare returns a compare function that imposes the reverse order of the specified compare function.
+//   - compare - the function used to compare list elements
+func ReverseCompare[T any](compare func(item1, item2 T) int) func(item1, item2 T) int {
+	return func(item1, item2 T) int { return compare(item2, item1) }
+}
@@ -0,0 +1,54 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSortListFunc(t *testing.T) {
+	list := NewLinkedListItems[string]("b", "C", "a", "B", "c", "A")
+	SortListFunc(list, func(item1, item2 string) int {
+		return cmp.Compare(strings.ToLower(item1), strings.ToLower(item2))
+	})
+	want := []string{"a", "A", "b", "B", "C", "c"}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("SortListFunc() got: %v, want: %v", actual, want)
+	}
+	checkListLinks(t, list)
+}
+
+func TestSortListFuncReverse(t *testing.T) {
+	list := NewLinkedListItems[listTestStruct](
+		listTestStruct{"a", 1}, listTestStruct{"b", 2}, listTestStruct{"c", 1}, listTestStruct{"d", 3})
+	SortListFuncReverse(list, func(item1, item2 listTestStruct) int { return cmp.Compare(item1.value, item2.value) })
+	want := []listTestStruct{{"d", 3}, {"b", 2}, {"a", 1}, {"c", 1}}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("SortListFuncReverse() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestSortOrdered(t *testing.T) {
+	list := NewLinkedListItems[float64](3.5, -1, 2.25, 0)
+	SortOrdered(list)
+	want := []float64{-1, 0, 2.25, 3.5}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("SortOrdered() got: %v, want: %v", actual, want)
+	}
+	if !IsSortedFunc(list, cmp.Compare[float64]) {
+		t.Fatal("the list is not sorted")
+	}
+	SortOrderedReverse(list)
+	want = []float64{3.5, 2.25, 0, -1}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("SortOrderedReverse() got: %v, want: %v", actual, want)
+	}
+	if !IsSortedFunc(list, ReverseCompare(cmp.Compare[float64])) {
+		t.Fatal("the list is not sorted in reverse order")
+	}
+}
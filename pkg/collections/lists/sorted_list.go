@@ -0,0 +1,73 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+// InsertSorted inserts the value into the list sorted in ascending order as determined by the compare function,
+// keeping the list sorted. The value is placed after all the elements equal to it.
+// The list is traversed from both ends at the same time, so the position closer to either end is found faster.
+// Returns the index of the inserted element.
+//   - value - the value to be inserted
+//   - compare - the function that returns a negative number when item1 < item2, a positive number
+//     when item1 > item2 and zero otherwise, such as cmp.Compare
+func InsertSorted[T any](list *LinkedList[T], value T, compare func(item1, item2 T) int) int {
+	front, back := list.first, list.last
+	i, j := 0, list.size-1
+	for ; i <= j; i, j = i+1, j-1 {
+		if compare(front.value, value) > 0 {
+			list.insertBetween(front.prev, front, value)
+			return i
+		}
+		if compare(back.value, value) <= 0 {
+			list.insertBetween(back, back.next, value)
+			return j + 1
+		}
+		front, back = front.next, back.prev
+	}
+	// all the elements before 'front' are not greater than the value and all the elements after 'back' are greater
+	list.insertBetween(back, front, value)
+	return i
+}
+
+// MergeSorted merges two lists sorted in ascending order as determined by the compare function
+// into a new sorted list in O(n+m) time. The items of the source lists are relinked into the new list,
+// so both source lists become empty. Elements of the list a precede equal elements of the list b.
+// If a and b are the same list, its items are moved to the new list unchanged.
+//   - compare - the function that returns a negative number when item1 < item2, a positive number
+//     when item1 > item2 and zero otherwise, such as cmp.Compare
+func MergeSorted[T any](a, b *LinkedList[T], compare func(item1, item2 T) int) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	if a == b {
+		result.first, result.last, result.size = a.first, a.last, a.size
+		a.Clear()
+		return result
+	}
+	head, tail := mergeItems(a.first, b.first, func(item1, item2 T) bool { return compare(item1, item2) < 0 })
+	var prev *listItem[T]
+	for item := head; item != nil; item = item.next {
+		item.prev = prev
+		prev = item
+	}
+	result.first, result.last, result.size = head, tail, a.size+b.size
+	a.Clear()
+	b.Clear()
+	return result
+}
+
+// DedupSorted removes adjacent duplicate elements from the list, keeping the first of each run of equal elements.
+// Applied to a sorted list, it leaves only unique elements.
+// Returns the number of elements removed.
+//   - compare - the function that returns zero for equal elements, such as cmp.Compare
+func DedupSorted[T any](list *LinkedList[T], compare func(item1, item2 T) int) int {
+	count := 0
+	for item := list.first; item != nil && item.next != nil; {
+		if compare(item.value, item.next.value) == 0 {
+			list.removeItem(item.next)
+			count++
+		} else {
+			item = item.next
+		}
+	}
+	return count
+}
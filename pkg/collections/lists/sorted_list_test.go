@@ -0,0 +1,109 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInsertSorted(t *testing.T) {
+	tests := []struct {
+		list      *LinkedList[int]
+		value     int
+		wantIndex int
+		wantArray []int
+	}{
+		{NewLinkedList[int](), 1, 0, []int{1}},
+		{NewLinkedListItems[int](2), 1, 0, []int{1, 2}},
+		{NewLinkedListItems[int](2), 3, 1, []int{2, 3}},
+		{NewLinkedListItems[int](2), 2, 1, []int{2, 2}},
+		{NewLinkedListItems[int](1, 3), 2, 1, []int{1, 2, 3}},
+		{NewLinkedListItems[int](1, 2, 4, 5), 3, 2, []int{1, 2, 3, 4, 5}},
+		{NewLinkedListItems[int](1, 2, 4, 5, 6), 3, 2, []int{1, 2, 3, 4, 5, 6}},
+		{NewLinkedListItems[int](1, 2, 3, 4, 6), 5, 4, []int{1, 2, 3, 4, 5, 6}},
+		{NewLinkedListItems[int](2, 3, 4, 5, 6), 1, 0, []int{1, 2, 3, 4, 5, 6}},
+		{NewLinkedListItems[int](1, 2, 2, 2, 3), 2, 4, []int{1, 2, 2, 2, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.list.ToArray(), "+", tt.value), func(t *testing.T) {
+			index := InsertSorted(tt.list, tt.value, cmp.Compare[int])
+			if index != tt.wantIndex {
+				t.Errorf("InsertSorted() index: %d, want: %d", index, tt.wantIndex)
+			}
+			if actual := tt.list.ToArray(); !reflect.DeepEqual(actual, tt.wantArray) {
+				t.Errorf("InsertSorted() got: %v, want: %v", actual, tt.wantArray)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
+
+func TestInsertSorted_stable(t *testing.T) {
+	list := NewLinkedList[listTestStruct]()
+	compare := func(item1, item2 listTestStruct) int { return cmp.Compare(item1.value, item2.value) }
+	for i, value := range []int{3, 1, 2, 1, 3, 2} {
+		InsertSorted(list, listTestStruct{name: fmt.Sprint(i), value: value}, compare)
+	}
+	want := []listTestStruct{{"1", 1}, {"3", 1}, {"2", 2}, {"5", 2}, {"0", 3}, {"4", 3}}
+	if actual := list.ToArray(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("InsertSorted() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	same := NewLinkedListItems(1, 3, 3)
+	tests := []struct {
+		a, b *LinkedList[int]
+		want []int
+	}{
+		{NewLinkedList[int](), NewLinkedList[int](), []int{}},
+		{NewLinkedListItems[int](1, 2), NewLinkedList[int](), []int{1, 2}},
+		{NewLinkedList[int](), NewLinkedListItems[int](1, 2), []int{1, 2}},
+		{NewLinkedListItems[int](1, 3, 5), NewLinkedListItems[int](2, 4, 6, 8), []int{1, 2, 3, 4, 5, 6, 8}},
+		{NewLinkedListItems[int](5, 6), NewLinkedListItems[int](1, 2), []int{1, 2, 5, 6}},
+		{same, same, []int{1, 3, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.a.ToArray(), tt.b.ToArray()), func(t *testing.T) {
+			result := MergeSorted(tt.a, tt.b, cmp.Compare[int])
+			if actual := result.ToArray(); !reflect.DeepEqual(actual, tt.want) {
+				t.Errorf("MergeSorted() got: %v, want: %v", actual, tt.want)
+			}
+			checkListLinks(t, result)
+			if tt.a.Size() != 0 || tt.b.Size() != 0 {
+				t.Error("the source lists must be empty")
+			}
+		})
+	}
+}
+
+func TestDedupSorted(t *testing.T) {
+	tests := []struct {
+		list      *LinkedList[int]
+		want      int
+		wantArray []int
+	}{
+		{NewLinkedList[int](), 0, []int{}},
+		{NewLinkedListItems[int](1), 0, []int{1}},
+		{NewLinkedListItems[int](1, 1), 1, []int{1}},
+		{NewLinkedListItems[int](1, 2, 3), 0, []int{1, 2, 3}},
+		{NewLinkedListItems[int](1, 1, 2, 3, 3, 3), 3, []int{1, 2, 3}},
+		{NewLinkedListItems[int](1, 2, 1, 1), 1, []int{1, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.list.ToArray()), func(t *testing.T) {
+			if got := DedupSorted(tt.list, cmp.Compare[int]); got != tt.want {
+				t.Errorf("DedupSorted() = %d, want %d", got, tt.want)
+			}
+			if actual := tt.list.ToArray(); !reflect.DeepEqual(actual, tt.wantArray) {
+				t.Errorf("DedupSorted() got: %v, want: %v", actual, tt.wantArray)
+			}
+			checkListLinks(t, tt.list)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import "iter"
+
+// OrderedMap is a map that remembers the order of its entries.
+// By default the entries are kept in insertion order: putting a new value for an existing key
+// does not change the position of the entry.
+// If the map was created by NewOrderedMapAccessOrder, the entries are kept in access order:
+// every Get or Put of a key moves the entry to the end of the map, so the first entry is
+// the least recently accessed one.
+// Get, Put, Delete and Has take O(1) time.
+// OrderedMap is not thread safe and not intended for concurrent usage.
+//   - K - key type
+//   - V - value type
+type OrderedMap[K comparable, V any] struct {
+	mp          map[K]*orderedMapEntry[K, V]
+	first       *orderedMapEntry[K, V]
+	last        *orderedMapEntry[K, V]
+	capacity    int
+	accessOrder bool
+}
+
+type orderedMapEntry[K comparable, V any] struct {
+	prev  *orderedMapEntry[K, V]
+	next  *orderedMapEntry[K, V]
+	key   K
+	value V
+}
+
+func (om *OrderedMap[K, V]) linkLast(entry *orderedMapEntry[K, V]) {
+	entry.prev, entry.next = om.last, nil
+	if om.last != nil {
+		om.last.next = entry
+	} else {
+		om.first = entry
+	}
+	om.last = entry
+}
+
+func (om *OrderedMap[K, V]) linkFirst(entry *orderedMapEntry[K, V]) {
+	entry.prev, entry.next = nil, om.first
+	if om.first != nil {
+		om.first.prev = entry
+	} else {
+		om.last = entry
+	}
+	om.first = entry
+}
+
+func (om *OrderedMap[K, V]) unlink(entry *orderedMapEntry[K, V]) {
+	if entry.prev != nil {
+		entry.prev.next = entry.next
+	} else {
+		om.first = entry.next
+	}
+	if entry.next != nil {
+		entry.next.prev = entry.prev
+	} else {
+		om.last = entry.prev
+	}
+	entry.prev, entry.next = nil, nil
+}
+
+func (om *OrderedMap[K, V]) touch(entry *orderedMapEntry[K, V]) {
+	if om.accessOrder && entry != om.last {
+		om.unlink(entry)
+		om.linkLast(entry)
+	}
+}
+
+// Get returns the value associated with the key and true if the key exists.
+// If the key does not exist, this method returns a default value of type V and false.
+// In access order mode the entry is moved to the end of the map.
+func (om *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if entry, ok := om.mp[key]; ok {
+		om.touch(entry)
+		return entry.value, true
+	}
+	var res V
+	return res, false
+}
+
+// Put associates the value with the key. A new key is added to the end of the map.
+// Returns the previous value associated with the key and true if the key existed,
+// otherwise returns a default value of type V and false.
+// In access order mode an existing entry is moved to the end of the map.
+func (om *OrderedMap[K, V]) Put(key K, value V) (V, bool) {
+	if entry, ok := om.mp[key]; ok {
+		old := entry.value
+		entry.value = value
+		om.touch(entry)
+		return old, true
+	}
+	entry := &orderedMapEntry[K, V]{key: key, value: value}
+	om.mp[key] = entry
+	om.linkLast(entry)
+	var res V
+	return res, false
+}
+
+// Delete removes the key from the map.
+// Returns the removed value and true if the key existed, otherwise returns a default value of type V and false.
+func (om *OrderedMap[K, V]) Delete(key K) (V, bool) {
+	if entry, ok := om.mp[key]; ok {
+		delete(om.mp, key)
+		om.unlink(entry)
+		return entry.value, true
+	}
+	var res V
+	return res, false
+}
+
+// Has returns true if the map contains the key. It does not change the order of the entries.
+func (om *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := om.mp[key]
+	return ok
+}
+
+// MoveToFront moves the entry with the specified key to the beginning of the map.
+// Returns false if the key does not exist.
+func (om *OrderedMap[K, V]) MoveToFront(key K) bool {
+	entry, ok := om.mp[key]
+	if ok && entry != om.first {
+		om.unlink(entry)
+		om.linkFirst(entry)
+	}
+	return ok
+}
+
+// MoveToBack moves the entry with the specified key to the end of the map.
+// Returns false if the key does not exist.
+func (om *OrderedMap[K, V]) MoveToBack(key K) bool {
+	entry, ok := om.mp[key]
+	if ok && entry != om.last {
+		om.unlink(entry)
+		om.linkLast(entry)
+	}
+	return ok
+}
+
+// First returns the key and the value of the first entry and true if the map is not empty.
+// If the map is empty, this method returns default values of types K and V and false.
+func (om *OrderedMap[K, V]) First() (K, V, bool) {
+	if om.first != nil {
+		return om.first.key, om.first.value, true
+	}
+	var key K
+	var value V
+	return key, value, false
+}
+
+// Last returns the key and the value of the last entry and true if the map is not empty.
+// If the map is empty, this method returns default values of types K and V and false.
+func (om *OrderedMap[K, V]) Last() (K, V, bool) {
+	if om.last != nil {
+		return om.last.key, om.last.value, true
+	}
+	var key K
+	var value V
+	return key, value, false
+}
+
+// Size returns the number of entries in the map.
+func (om *OrderedMap[K, V]) Size() int {
+	return len(om.mp)
+}
+
+// IsEmpty returns true if the map does not contain any entries.
+func (om *OrderedMap[K, V]) IsEmpty() bool {
+	return len(om.mp) == 0
+}
+
+// Clear removes all the entries from the map.
+func (om *OrderedMap[K, V]) Clear() {
+	if om.capacity > 0 {
+		om.mp = make(map[K]*orderedMapEntry[K, V], om.capacity)
+	} else {
+		om.mp = make(map[K]*orderedMapEntry[K, V])
+	}
+	om.first = nil
+	om.last = nil
+}
+
+// Capacity returns the capacity value that was set when the map was created.
+func (om *OrderedMap[K, V]) Capacity() int {
+	return om.capacity
+}
+
+// IsAccessOrder returns true if the entries are kept in access order rather than in insertion order.
+func (om *OrderedMap[K, V]) IsAccessOrder() bool {
+	return om.accessOrder
+}
+
+// Keys returns an iterator over the map keys in order.
+// The current key may be deleted from the map during the iteration.
+func (om *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range om.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the map values in order.
+// The key of the current value may be deleted from the map during the iteration.
+func (om *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range om.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the key-value pairs of the map in order.
+// The iteration does not change the order of the entries.
+// The current entry may be deleted from the map or moved during the iteration.
+func (om *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := om.first; entry != nil; {
+			next := entry.next
+			if !yield(entry.key, entry.value) {
+				return
+			}
+			entry = next
+		}
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the map in reverse order.
+// The current entry may be deleted from the map or moved during the iteration.
+func (om *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := om.last; entry != nil; {
+			prev := entry.prev
+			if !yield(entry.key, entry.value) {
+				return
+			}
+			entry = prev
+		}
+	}
+}
+
+// NewOrderedMap returns a new empty OrderedMap instance that keeps the entries in insertion order.
+//   - K - key type
+//   - V - value type
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return NewOrderedMapCapacity[K, V](0)
+}
+
+// NewOrderedMapCapacity returns a new empty OrderedMap instance that keeps the entries in insertion order
+// and has an initial space size (capacity).
+//   - K - key type
+//   - V - value type
+//   - capacity - initial space size
+func NewOrderedMapCapacity[K comparable, V any](capacity int) *OrderedMap[K, V] {
+	result := &OrderedMap[K, V]{capacity: capacity}
+	result.Clear()
+	return result
+}
+
+// NewOrderedMapAccessOrder returns a new empty OrderedMap instance that keeps the entries in access order,
+// from the least recently accessed to the most recently accessed one.
+//   - K - key type
+//   - V - value type
+//   - capacity - initial space size
+func NewOrderedMapAccessOrder[K comparable, V any](capacity int) *OrderedMap[K, V] {
+	result := NewOrderedMapCapacity[K, V](capacity)
+	result.accessOrder = true
+	return result
+}
@@ -0,0 +1,82 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The map is encoded as a JSON object whose members follow the order of the map entries.
+// The keys must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func (om OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for entry := om.first; entry != nil; entry = entry.next {
+		if entry != om.first {
+			buf.WriteByte(',')
+		}
+		text, err := codec.ValueToText(entry.key)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(text)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The map is decoded from a JSON object, replacing the current contents of the map;
+// the entries are added in the order of the object members. If a key is repeated, the last value wins
+// and the entry keeps the position of the first occurrence. JSON null is decoded as an empty map.
+// The map is not changed if an error is returned.
+func (om *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoded := NewOrderedMapCapacity[K, V](om.capacity)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != nil {
+		if token != json.Delim('{') {
+			return fmt.Errorf("collections: cannot unmarshal %v into OrderedMap", token)
+		}
+		for dec.More() {
+			if token, err = dec.Token(); err != nil {
+				return err
+			}
+			var key K
+			if err = codec.TextToValue(token.(string), &key); err != nil {
+				return err
+			}
+			var value V
+			if err = dec.Decode(&value); err != nil {
+				return err
+			}
+			decoded.Put(key, value)
+		}
+		if _, err = dec.Token(); err != nil {
+			return err
+		}
+	}
+	decoded.accessOrder = om.accessOrder
+	*om = *decoded
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"encoding/json"
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+func TestNewOrderedMap(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	if om.Size() != 0 || !om.IsEmpty() {
+		t.Fatal("the map isn't empty")
+	}
+	if om.Capacity() != 0 || om.IsAccessOrder() {
+		t.Fatalf("invalid map settings: capacity: %d, access order: %t", om.Capacity(), om.IsAccessOrder())
+	}
+	if _, _, ok := om.First(); ok {
+		t.Fatal("the first entry exists")
+	}
+	if _, _, ok := om.Last(); ok {
+		t.Fatal("the last entry exists")
+	}
+	if om = NewOrderedMapAccessOrder[string, int](5); om.Capacity() != 5 || !om.IsAccessOrder() {
+		t.Fatalf("invalid map settings: capacity: %d, access order: %t", om.Capacity(), om.IsAccessOrder())
+	}
+}
+
+func TestOrderedMap_PutGetDelete(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	for i, key := range []string{"c", "a", "b"} {
+		if _, ok := om.Put(key, i); ok {
+			t.Fatalf("the key %s existed", key)
+		}
+	}
+	if old, ok := om.Put("c", 10); !ok || old != 0 {
+		t.Fatalf("Put() got: %d, %t, want: %d, true", old, ok, 0)
+	}
+	if v, ok := om.Get("c"); !ok || v != 10 {
+		t.Fatalf("Get() got: %d, %t, want: %d, true", v, ok, 10)
+	}
+	if _, ok := om.Get("d"); ok {
+		t.Fatal("unknown key was found")
+	}
+	if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, []string{"c", "a", "b"}) {
+		t.Fatalf("Keys() got: %v, want: %v", keys, []string{"c", "a", "b"})
+	}
+	if values := slices.Collect(om.Values()); !reflect.DeepEqual(values, []int{10, 1, 2}) {
+		t.Fatalf("Values() got: %v, want: %v", values, []int{10, 1, 2})
+	}
+	if v, ok := om.Delete("a"); !ok || v != 1 {
+		t.Fatalf("Delete() got: %d, %t, want: %d, true", v, ok, 1)
+	}
+	if _, ok := om.Delete("a"); ok || om.Has("a") {
+		t.Fatal("the key was not deleted")
+	}
+	if !om.Has("b") || om.Size() != 2 {
+		t.Fatalf("the map was corrupted: %v", slices.Collect(om.Keys()))
+	}
+	om.Delete("c")
+	om.Delete("b")
+	if om.first != nil || om.last != nil || !om.IsEmpty() {
+		t.Fatal("the map isn't empty")
+	}
+	om.Put("x", 1)
+	om.Clear()
+	if !om.IsEmpty() || len(slices.Collect(om.Keys())) != 0 {
+		t.Fatal("the map was not cleared")
+	}
+}
+
+func TestOrderedMap_accessOrder(t *testing.T) {
+	om := NewOrderedMapAccessOrder[int, string](0)
+	om.Put(1, "one")
+	om.Put(2, "two")
+	om.Put(3, "three")
+	om.Get(1)
+	om.Put(2, "TWO")
+	om.Has(3)
+	if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, []int{3, 1, 2}) {
+		t.Fatalf("Keys() got: %v, want: %v", keys, []int{3, 1, 2})
+	}
+	if key, value, _ := om.First(); key != 3 || value != "three" {
+		t.Fatalf("First() got: %d, %s, want: %d, %s", key, value, 3, "three")
+	}
+	if key, value, _ := om.Last(); key != 2 || value != "TWO" {
+		t.Fatalf("Last() got: %d, %s, want: %d, %s", key, value, 2, "TWO")
+	}
+}
+
+func TestOrderedMap_Move(t *testing.T) {
+	om := NewOrderedMap[int, int]()
+	for i := 1; i <= 4; i++ {
+		om.Put(i, i*i)
+	}
+	if !om.MoveToFront(3) || !om.MoveToBack(1) || !om.MoveToFront(3) || !om.MoveToBack(1) {
+		t.Fatal("existing key was not moved")
+	}
+	if om.MoveToFront(5) || om.MoveToBack(5) {
+		t.Fatal("unknown key was moved")
+	}
+	want := []int{3, 2, 4, 1}
+	if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, want) {
+		t.Fatalf("Keys() got: %v, want: %v", keys, want)
+	}
+	var keys, values []int
+	for k, v := range om.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(keys, want) || !reflect.DeepEqual(values, []int{9, 4, 16, 1}) {
+		t.Fatalf("All() got: %v, %v", keys, values)
+	}
+	keys = keys[:0]
+	for k := range om.Backward() {
+		keys = append(keys, k)
+		if len(keys) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []int{1, 4}) {
+		t.Fatalf("Backward() got: %v, want: %v", keys, []int{1, 4})
+	}
+}
+
+func TestOrderedMap_Delete_iteration(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  func(om *OrderedMap[int, int]) iter.Seq2[int, int]
+	}{
+		{name: "All", seq: (*OrderedMap[int, int]).All},
+		{name: "Backward", seq: (*OrderedMap[int, int]).Backward},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			om := NewOrderedMap[int, int]()
+			for i := 1; i <= 4; i++ {
+				om.Put(i, i*i)
+			}
+			var visited int
+			for k := range tt.seq(om) {
+				om.Delete(k)
+				visited++
+			}
+			if visited != 4 || !om.IsEmpty() || om.first != nil || om.last != nil {
+				t.Fatalf("%s() visited: %d, left: %v, want: 4 visited, empty map", tt.name, visited, slices.Collect(om.Keys()))
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Move_iteration(t *testing.T) {
+	om := NewOrderedMapAccessOrder[int, int](0)
+	for i := 1; i <= 4; i++ {
+		om.Put(i, i*i)
+	}
+	var keys []int
+	for k := range om.Keys() {
+		keys = append(keys, k)
+		if k == 2 {
+			om.Get(k)
+		}
+		if len(keys) > 5 {
+			break
+		}
+	}
+	want := []int{1, 2, 3, 4, 2}
+	if !reflect.DeepEqual(keys, want) {
+		t.Fatalf("Keys() got: %v, want: %v", keys, want)
+	}
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	om := NewOrderedMap[string, []int]()
+	om.Put("z", []int{1})
+	om.Put("a", nil)
+	om.Put("m \"q\"", []int{2, 3})
+	data, err := json.Marshal(om)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"z":[1],"a":null,"m \"q\"":[2,3]}`
+	if string(data) != want {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, want)
+	}
+	decoded := NewOrderedMap[string, []int]()
+	decoded.Put("old", nil)
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if keys := slices.Collect(decoded.Keys()); !reflect.DeepEqual(keys, []string{"z", "a", "m \"q\""}) {
+		t.Fatalf("UnmarshalJSON() keys: %v", keys)
+	}
+	if !reflect.DeepEqual(slices.Collect(decoded.Values()), slices.Collect(om.Values())) {
+		t.Fatalf("UnmarshalJSON() values got: %v, want: %v", slices.Collect(decoded.Values()), slices.Collect(om.Values()))
+	}
+	if data, _ = json.Marshal(NewOrderedMap[int, bool]()); string(data) != "{}" {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, "{}")
+	}
+}
+
+func TestOrderedMap_JSON_value(t *testing.T) {
+	type payload struct {
+		Map OrderedMap[string, int] `json:"map"`
+	}
+	value := payload{Map: *NewOrderedMap[string, int]()}
+	value.Map.Put("b", 2)
+	value.Map.Put("a", 1)
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"map":{"b":2,"a":1}}`; string(data) != want {
+		t.Fatalf("MarshalJSON() got: %s, want: %s", data, want)
+	}
+}
+
+func TestOrderedMap_UnmarshalJSON_accessOrder(t *testing.T) {
+	om := NewOrderedMapAccessOrder[string, int](0)
+	if err := json.Unmarshal([]byte(`{"a":1,"b":2,"a":3}`), om); err != nil {
+		t.Fatal(err)
+	}
+	if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Fatalf("UnmarshalJSON() keys got: %v, want: %v", keys, []string{"a", "b"})
+	}
+	if v, _ := om.Get("a"); v != 3 {
+		t.Fatalf("Get() got: %d, want: %d", v, 3)
+	}
+	if !om.IsAccessOrder() {
+		t.Fatal("the map lost the access order mode")
+	}
+	if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, []string{"b", "a"}) {
+		t.Fatalf("Keys() after Get() got: %v, want: %v", keys, []string{"b", "a"})
+	}
+}
+
+func TestOrderedMap_UnmarshalJSON(t *testing.T) {
+	type testCase struct {
+		name    string
+		data    string
+		want    []int
+		wantErr bool
+	}
+	tests := []testCase{
+		{"int keys", `{"3":"c","1":"a","2":"b"}`, []int{3, 1, 2}, false},
+		{"repeated key", `{"3":"c","1":"a","3":"C"}`, []int{3, 1}, false},
+		{"null", `null`, nil, false},
+		{"array", `[1,2]`, nil, true},
+		{"invalid key", `{"x":"a"}`, nil, true},
+		{"invalid value", `{"1":1}`, nil, true},
+		{"truncated", `{"1":"a"`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			om := NewOrderedMap[int, string]()
+			om.Put(7, "g")
+			err := json.Unmarshal([]byte(tt.data), om)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error: %v, wantErr: %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, []int{7}) {
+					t.Fatalf("the map was changed: %v", keys)
+				}
+				return
+			}
+			if keys := slices.Collect(om.Keys()); !reflect.DeepEqual(keys, tt.want) {
+				t.Fatalf("UnmarshalJSON() got: %v, want: %v", keys, tt.want)
+			}
+		})
+	}
+	om := NewOrderedMap[struct{ a int }, int]()
+	om.Put(struct{ a int }{1}, 1)
+	if _, err := json.Marshal(om); !errors.Is(err, codec.ErrUnsupportedTextType) {
+		t.Fatalf("MarshalJSON() error: %v, want: %v", err, codec.ErrUnsupportedTextType)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import "iter"
+
+// OrderedSet is a collection that does not contain duplicate elements and remembers the order
+// in which the elements were added (insertion order).
+// Add, Remove and Contains take O(1) time, the iteration follows the insertion order.
+// OrderedSet is not thread safe and not intended for concurrent usage.
+//   - T - value type
+type OrderedSet[T comparable] struct {
+	mp       map[T]*orderedSetItem[T]
+	first    *orderedSetItem[T]
+	last     *orderedSetItem[T]
+	capacity int
+}
+
+type orderedSetItem[T comparable] struct {
+	prev  *orderedSetItem[T]
+	next  *orderedSetItem[T]
+	value T
+}
+
+func (set *OrderedSet[T]) link(item *orderedSetItem[T]) {
+	item.prev, item.next = set.last, nil
+	if set.last != nil {
+		set.last.next = item
+	} else {
+		set.first = item
+	}
+	set.last = item
+}
+
+func (set *OrderedSet[T]) unlink(item *orderedSetItem[T]) {
+	if item.prev != nil {
+		item.prev.next = item.next
+	} else {
+		set.first = item.next
+	}
+	if item.next != nil {
+		item.next.prev = item.prev
+	} else {
+		set.last = item.prev
+	}
+	item.prev, item.next = nil, nil
+}
+
+// Add adds a specified value to the end of the set if the set does not contain it.
+// The position of an existing value does not change.
+// Returns true if the value did not exist and was added to the set, otherwise returns false.
+func (set *OrderedSet[T]) Add(value T) bool {
+	if _, ok := set.mp[value]; ok {
+		return false
+	}
+	item := &orderedSetItem[T]{value: value}
+	set.mp[value] = item
+	set.link(item)
+	return true
+}
+
+// AddLast adds a specified value to the end of the set; if the set already contains the value,
+// the value is moved to the end of the set.
+// Returns true if the value did not exist and was added to the set, otherwise returns false.
+func (set *OrderedSet[T]) AddLast(value T) bool {
+	if item, ok := set.mp[value]; ok {
+		if item != set.last {
+			set.unlink(item)
+			set.link(item)
+		}
+		return false
+	}
+	return set.Add(value)
+}
+
+// AddAll adds all the specified values to the end of the set in the order they are given.
+// Returns true if this set changed as result of the call.
+func (set *OrderedSet[T]) AddAll(values ...T) bool {
+	var changed bool
+	for _, value := range values {
+		if set.Add(value) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Contains returns true if the set contains the value
+func (set *OrderedSet[T]) Contains(value T) bool {
+	_, ok := set.mp[value]
+	return ok
+}
+
+// Remove removes a value from the set.
+// Returns true if this set changed as result of the call.
+func (set *OrderedSet[T]) Remove(value T) bool {
+	if item, ok := set.mp[value]; ok {
+		delete(set.mp, value)
+		set.unlink(item)
+		return true
+	}
+	return false
+}
+
+// First returns the first (the earliest added) element of the set and true if it exists.
+// If the set is empty, this method returns a default value of type T and false.
+func (set *OrderedSet[T]) First() (T, bool) {
+	if set.first != nil {
+		return set.first.value, true
+	}
+	var res T
+	return res, false
+}
+
+// Last returns the last (the latest added) element of the set and true if it exists.
+// If the set is empty, this method returns a default value of type T and false.
+func (set *OrderedSet[T]) Last() (T, bool) {
+	if set.last != nil {
+		return set.last.value, true
+	}
+	var res T
+	return res, false
+}
+
+// RemoveFirst removes the first element from the set and returns its value and true if it exists.
+// If the set is empty, a default value of type T and false is returned.
+func (set *OrderedSet[T]) RemoveFirst() (T, bool) {
+	res, ok := set.First()
+	if ok {
+		set.Remove(res)
+	}
+	return res, ok
+}
+
+// RemoveLast removes the last element from the set and returns its value and true if it exists.
+// If the set is empty, a default value of type T and false is returned.
+func (set *OrderedSet[T]) RemoveLast() (T, bool) {
+	res, ok := set.Last()
+	if ok {
+		set.Remove(res)
+	}
+	return res, ok
+}
+
+// Size returns the current size of the set.
+func (set *OrderedSet[T]) Size() int {
+	return len(set.mp)
+}
+
+// IsEmpty returns true if the set does not contain any values.
+func (set *OrderedSet[T]) IsEmpty() bool {
+	return len(set.mp) == 0
+}
+
+// TrimToSize trims the capacity of this set instance to be set's current size.
+func (set *OrderedSet[T]) TrimToSize() {
+	set.mp = CopyMap(set.mp)
+}
+
+// Clear clears the set.
+func (set *OrderedSet[T]) Clear() {
+	if set.capacity > 0 {
+		set.mp = make(map[T]*orderedSetItem[T], set.capacity)
+	} else {
+		set.mp = make(map[T]*orderedSetItem[T])
+	}
+	set.first = nil
+	set.last = nil
+}
+
+// Capacity returns the capacity value that was set when the set was created.
+func (set *OrderedSet[T]) Capacity() int {
+	return set.capacity
+}
+
+// ToSlice return a slice of the set elements in insertion order.
+func (set *OrderedSet[T]) ToSlice() []T {
+	result := make([]T, 0, len(set.mp))
+	for item := set.first; item != nil; item = item.next {
+		result = append(result, item.value)
+	}
+	return result
+}
+
+// All returns an iterator over the set elements in insertion order.
+// The current element may be removed from the set during the iteration.
+func (set *OrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := set.first; item != nil; {
+			next := item.next
+			if !yield(item.value) {
+				return
+			}
+			item = next
+		}
+	}
+}
+
+// Values returns an iterator over the set elements in insertion order, the same as All.
+func (set *OrderedSet[T]) Values() iter.Seq[T] {
+	return set.All()
+}
+
+// Backward returns an iterator over the set elements in reverse insertion order.
+// The current element may be removed from the set during the iteration.
+func (set *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := set.last; item != nil; {
+			prev := item.prev
+			if !yield(item.value) {
+				return
+			}
+			item = prev
+		}
+	}
+}
+
+// NewOrderedSet returns a new empty OrderedSet instance with capacity equal 0.
+//   - T - value type
+func NewOrderedSet[T comparable]() *OrderedSet[T] {
+	return NewOrderedSetCapacity[T](0)
+}
+
+// NewOrderedSetCapacity returns a new empty OrderedSet instance with an initial space size (capacity)
+//   - T - value type
+//   - capacity - initial space size
+func NewOrderedSetCapacity[T comparable](capacity int) *OrderedSet[T] {
+	result := &OrderedSet[T]{capacity: capacity}
+	result.Clear()
+	return result
+}
+
+// NewOrderedSetItems returns a new instance of OrderedSet containing specified values in the order they are given.
+// The set capacity is equal to the number of values.
+//   - values ...T - values that the set will contain
+func NewOrderedSetItems[T comparable](values ...T) *OrderedSet[T] {
+	result := NewOrderedSetCapacity[T](len(values))
+	result.AddAll(values...)
+	return result
+}
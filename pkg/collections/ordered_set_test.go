@@ -0,0 +1,148 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewOrderedSet(t *testing.T) {
+	set := NewOrderedSet[int]()
+	if set.Size() != 0 || !set.IsEmpty() {
+		t.Fatal("the set isn't empty")
+	}
+	if set.Capacity() != 0 {
+		t.Fatalf("invalid capacity, expected: %d, actual: %d", 0, set.Capacity())
+	}
+	if set = NewOrderedSetCapacity[int](7); set.Capacity() != 7 {
+		t.Fatalf("invalid capacity, expected: %d, actual: %d", 7, set.Capacity())
+	}
+	if _, ok := set.First(); ok {
+		t.Fatal("the first element exists")
+	}
+	if _, ok := set.Last(); ok {
+		t.Fatal("the last element exists")
+	}
+}
+
+func TestOrderedSet_Add(t *testing.T) {
+	set := NewOrderedSet[string]()
+	for _, value := range []string{"c", "a", "b"} {
+		if !set.Add(value) {
+			t.Fatalf("value %s was not added to the set", value)
+		}
+	}
+	if set.Add("c") || set.AddAll("a", "b") {
+		t.Fatal("dublicate value was added to the set")
+	}
+	want := []string{"c", "a", "b"}
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("ToSlice() got: %v, want: %v", actual, want)
+	}
+	if actual := slices.Collect(set.All()); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("All() got: %v, want: %v", actual, want)
+	}
+	if actual := slices.Collect(set.Backward()); !reflect.DeepEqual(actual, []string{"b", "a", "c"}) {
+		t.Fatalf("Backward() got: %v, want: %v", actual, []string{"b", "a", "c"})
+	}
+}
+
+func TestOrderedSet_AddLast(t *testing.T) {
+	set := NewOrderedSetItems(1, 2, 3)
+	if set.AddLast(1) {
+		t.Fatal("existing value was reported as added")
+	}
+	if set.AddLast(3) {
+		t.Fatal("existing value was reported as added")
+	}
+	if !set.AddLast(4) {
+		t.Fatal("the value was not added")
+	}
+	want := []int{2, 1, 3, 4}
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("AddLast() got: %v, want: %v", actual, want)
+	}
+	if first, _ := set.First(); first != 2 {
+		t.Fatalf("First() got: %d, want: %d", first, 2)
+	}
+}
+
+func TestOrderedSet_Remove(t *testing.T) {
+	set := NewOrderedSetItems(1, 2, 3, 4, 5)
+	if !set.Remove(3) || set.Remove(3) || set.Contains(3) {
+		t.Fatal("the value 3 was not removed properly")
+	}
+	if v, ok := set.RemoveFirst(); !ok || v != 1 {
+		t.Fatalf("RemoveFirst() got: %d, %t, want: %d, true", v, ok, 1)
+	}
+	if v, ok := set.RemoveLast(); !ok || v != 5 {
+		t.Fatalf("RemoveLast() got: %d, %t, want: %d, true", v, ok, 5)
+	}
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, []int{2, 4}) {
+		t.Fatalf("got: %v, want: %v", actual, []int{2, 4})
+	}
+	set.RemoveFirst()
+	set.RemoveLast()
+	if _, ok := set.RemoveFirst(); ok {
+		t.Fatal("the set must be empty")
+	}
+	if _, ok := set.RemoveLast(); ok {
+		t.Fatal("the set must be empty")
+	}
+	if set.first != nil || set.last != nil {
+		t.Fatal("the first and the last items must be nil")
+	}
+	set.Add(6)
+	if first, _ := set.First(); first != 6 {
+		t.Fatalf("First() got: %d, want: %d", first, 6)
+	}
+	if last, _ := set.Last(); last != 6 {
+		t.Fatalf("Last() got: %d, want: %d", last, 6)
+	}
+}
+
+func TestOrderedSet_Remove_iteration(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  func(set *OrderedSet[int]) iter.Seq[int]
+	}{
+		{name: "All", seq: (*OrderedSet[int]).All},
+		{name: "Backward", seq: (*OrderedSet[int]).Backward},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := NewOrderedSetItems(1, 2, 3, 4)
+			for v := range tt.seq(set) {
+				if v%2 == 1 {
+					set.Remove(v)
+				}
+			}
+			if actual := set.ToSlice(); !reflect.DeepEqual(actual, []int{2, 4}) {
+				t.Fatalf("%s() got: %v, want: %v", tt.name, actual, []int{2, 4})
+			}
+			for v := range tt.seq(set) {
+				set.Remove(v)
+			}
+			if !set.IsEmpty() || set.first != nil || set.last != nil {
+				t.Fatalf("%s() got: %v, want: empty set", tt.name, set.ToSlice())
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Clear(t *testing.T) {
+	set := NewOrderedSetItems("a", "b")
+	set.TrimToSize()
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, []string{"a", "b"}) {
+		t.Fatalf("TrimToSize() got: %v", actual)
+	}
+	set.Clear()
+	if !set.IsEmpty() || len(set.ToSlice()) != 0 {
+		t.Fatal("the set was not cleared")
+	}
+}
@@ -4,6 +4,8 @@
 
 package collections
 
+import "iter"
+
 // Set is a collection that does not contain duplicate elements.
 // Set is not thread safe and not intended for concurrent usage.
 //   - T - value type
@@ -90,6 +92,23 @@ func (set *Set[T]) ToSlice() []T {
 	return result
 }
 
+// All returns an iterator over the set elements.
+// The iteration order is not specified and is not guaranteed to be the same from one call to the next.
+func (set *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range set.mp {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this Set in an unspecified order, the same as All.
+func (set *Set[T]) Values() iter.Seq[T] {
+	return set.All()
+}
+
 // NewSet returns a new empty Set instance with capacity equal 0.
 //   - T - value type
 func NewSet[T comparable]() Set[T] {
@@ -0,0 +1,56 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// WriteBinary writes the Set to the writer in a versioned length-prefixed binary format,
+// in which the elements are encoded with encoding/gob one by one without copying them to a slice.
+func (set Set[T]) WriteBinary(w io.Writer) error {
+	return codec.Encode(w, len(set.mp), set.All())
+}
+
+// ReadBinary reads the Set written by WriteBinary from the reader, replacing the current contents of the Set.
+// The elements are added to the Set as they are decoded. The Set is not changed if an error is returned.
+// If the reader does not implement io.ByteReader, it may be read beyond the end of the Set data.
+func (set *Set[T]) ReadBinary(r io.Reader) error {
+	var mp map[T]struct{}
+	err := codec.Decode(r,
+		func(size int) { mp = make(map[T]struct{}, max(size, set.capacity)) },
+		func(value T) { mp[value] = struct{}{} })
+	if err == nil {
+		set.mp = mp
+	}
+	return err
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (set Set[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := set.WriteBinary(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (set *Set[T]) UnmarshalBinary(data []byte) error {
+	return set.ReadBinary(bytes.NewReader(data))
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (set Set[T]) GobEncode() ([]byte, error) {
+	return set.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (set *Set[T]) GobDecode(data []byte) error {
+	return set.UnmarshalBinary(data)
+}
@@ -0,0 +1,83 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestSet_MarshalBinary(t *testing.T) {
+	const amount = 100_000
+	set := NewSet[int]()
+	for i := 0; i < amount; i++ {
+		set.Add(i)
+	}
+	data, err := set.MarshalBinary()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	decoded := NewSetItems(-1)
+	if err = decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !decoded.Equal(set) {
+		t.Fatalf("the decoded set differs, size: %d, want: %d", decoded.Size(), set.Size())
+	}
+}
+
+func TestSet_UnmarshalBinary_fail(t *testing.T) {
+	set := NewSetItems("a", "b")
+	data, _ := set.MarshalBinary()
+	decoded := NewSetItems("c")
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if actual := decoded.ToSlice(); !reflect.DeepEqual(actual, []string{"c"}) {
+		t.Fatalf("the set was changed: %v", actual)
+	}
+}
+
+func TestSet_ReadBinary(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewSetItems(1, 2).WriteBinary(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if err := NewSetItems(3).WriteBinary(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var first, second Set[int]
+	if err := first.ReadBinary(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if err := second.ReadBinary(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !first.Equal(NewSetItems(1, 2)) || !second.Equal(NewSetItems(3)) {
+		t.Fatalf("ReadBinary() got: %v, %v", first.ToSlice(), second.ToSlice())
+	}
+}
+
+func TestSet_Gob(t *testing.T) {
+	type snapshot struct {
+		Name string
+		Tags Set[string]
+	}
+	var buf bytes.Buffer
+	source := snapshot{Name: "name", Tags: NewSetItems("a", "b", "c")}
+	if err := gob.NewEncoder(&buf).Encode(source); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var decoded snapshot
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if decoded.Name != source.Name || !decoded.Tags.Equal(source.Tags) {
+		t.Fatalf("gob got: %v %v, want: %v %v", decoded.Name, decoded.Tags.ToSlice(), source.Name,
+			source.Tags.ToSlice())
+	}
+}
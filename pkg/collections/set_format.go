@@ -0,0 +1,46 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"fmt"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+// String implements the fmt.Stringer interface.
+// Returns the elements of the Set in square brackets in an unspecified order, e.g. '[1 2 3]'.
+func (set Set[T]) String() string {
+	return fmt.Sprint(set.ToSlice())
+}
+
+// Format implements the fmt.Formatter interface.
+//   - %v - the elements of the Set, e.g. '[1 2 3]'
+//   - %+v - the size and the capacity of the Set followed by its elements
+//   - %#v - Go-syntax representation, e.g. 'collections.NewSetItems[int](1, 2, 3)'
+//   - other verbs are applied to the elements, e.g. %q or %x
+func (set Set[T]) Format(f fmt.State, verb rune) {
+	codec.Format(f, verb, "collections.NewSetItems",
+		fmt.Sprintf("size: %d, capacity: %d", set.Size(), set.capacity), set.ToSlice())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The Set is encoded as a comma-separated (CSV) list of its elements in an unspecified order.
+// The elements must implement encoding.TextMarshaler or be of a string, boolean, integer or floating-point kind.
+func (set Set[T]) MarshalText() ([]byte, error) {
+	return codec.MarshalText(set.All())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The Set is decoded from a comma-separated (CSV) list of elements, replacing the current contents of the Set.
+// Duplicate elements are merged. The Set is not changed if an error is returned.
+func (set *Set[T]) UnmarshalText(text []byte) error {
+	decoded := NewSetCapacity[T](set.capacity)
+	if err := codec.UnmarshalText(text, func(value T) { decoded.mp[value] = struct{}{} }); err != nil {
+		return err
+	}
+	set.mp = decoded.mp
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+func TestSet_Format(t *testing.T) {
+	set := NewSetCapacity[string](5)
+	set.Add("a")
+	tests := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", set, "[a]"},
+		{"%s", set, "[a]"},
+		{"%q", set, `["a"]`},
+		{"%+v", set, "{size: 1, capacity: 5, elements: [a]}"},
+		{"%#v", set, `collections.NewSetItems[string]("a")`},
+		{"%v", &set, "[a]"},
+		{"%#v", NewSet[int](), "collections.NewSetItems[int]()"},
+		{"%d", NewSetItems(10), "[10]"},
+		{"%x", NewSetItems(10), "[a]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if actual := fmt.Sprintf(tt.format, tt.value); actual != tt.want {
+				t.Errorf("Sprintf(%s) got: '%s', want: '%s'", tt.format, actual, tt.want)
+			}
+		})
+	}
+	if actual := set.String(); actual != "[a]" {
+		t.Fatalf("String() got: '%s', want: '%s'", actual, "[a]")
+	}
+}
+
+func TestSet_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		set  Set[string]
+		want string
+	}{
+		{"empty", NewSet[string](), ""},
+		{"empty string", NewSetItems(""), `""`},
+		{"single", NewSetItems("a"), "a"},
+		{"quoted", NewSetItems("a,b"), `"a,b"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.set.MarshalText()
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() got: '%s', want: '%s'", text, tt.want)
+			}
+			decoded := NewSetItems("old")
+			if err = decoded.UnmarshalText(text); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if !decoded.Equal(tt.set) {
+				t.Errorf("UnmarshalText() got: %v, want: %v", decoded, tt.set)
+			}
+		})
+	}
+}
+
+func TestSet_UnmarshalText(t *testing.T) {
+	var numbers Set[int]
+	if err := numbers.UnmarshalText([]byte("3,1,2,1")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := sortedSlice(numbers); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("UnmarshalText() got: %v, want: %v", actual, []int{1, 2, 3})
+	}
+	if err := numbers.UnmarshalText([]byte("1,x")); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if numbers.Size() != 3 {
+		t.Fatalf("the set was changed: %v", numbers)
+	}
+	var addresses Set[netip.Addr]
+	if err := addresses.UnmarshalText([]byte("127.0.0.1,::1")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !addresses.Contains(netip.MustParseAddr("::1")) || addresses.Size() != 2 {
+		t.Fatalf("UnmarshalText() got: %v", addresses)
+	}
+	text, err := addresses.MarshalText()
+	if err != nil || (string(text) != "127.0.0.1,::1" && string(text) != "::1,127.0.0.1") {
+		t.Fatalf("MarshalText() got: '%s', %v", text, err)
+	}
+	if _, err = NewSetItems(struct{}{}).MarshalText(); !errors.Is(err, codec.ErrUnsupportedTextType) {
+		t.Fatalf("expected error: '%v', got: '%v'", codec.ErrUnsupportedTextType, err)
+	}
+}
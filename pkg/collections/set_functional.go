@@ -0,0 +1,126 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+// Map returns a new Set containing the results of applying the mapper function to the elements of the set.
+// The result may contain fewer elements than the source set if the mapper returns equal values.
+//   - mapper - the function that is applied to each element
+func Map[T, R comparable](set Set[T], mapper func(value T) R) Set[R] {
+	result := NewSetCapacity[R](len(set.mp))
+	for value := range set.mp {
+		result.mp[mapper(value)] = struct{}{}
+	}
+	return result
+}
+
+// FlatMap returns a new Set containing all the values returned by the mapper function
+// applied to the elements of the set.
+//   - mapper - the function that is applied to each element and returns the values to be added to the result
+func FlatMap[T, R comparable](set Set[T], mapper func(value T) []R) Set[R] {
+	result := NewSet[R]()
+	for value := range set.mp {
+		result.AddAll(mapper(value)...)
+	}
+	return result
+}
+
+// Filter returns a new Set containing the elements of the set that satisfy the condition.
+//   - match - the function that is applied to each element to determine if it should be included in the result
+func Filter[T comparable](set Set[T], match func(value T) bool) Set[T] {
+	result := NewSet[T]()
+	for value := range set.mp {
+		if match(value) {
+			result.mp[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Reduce performs a reduction of the elements of the set using the initial value and the accumulator function,
+// and returns the reduced value. The elements are processed in an unspecified order.
+//   - initial - the initial value of the reduction
+//   - accumulator - the function that combines the accumulated value with the next element
+func Reduce[T comparable, R any](set Set[T], initial R, accumulator func(acc R, value T) R) R {
+	result := initial
+	for value := range set.mp {
+		result = accumulator(result, value)
+	}
+	return result
+}
+
+// GroupBy groups the elements of the set by the key returned by the classifier function.
+// Returns a map of keys to sets of elements.
+//   - classifier - the function that returns the key of an element
+func GroupBy[T, K comparable](set Set[T], classifier func(value T) K) map[K]Set[T] {
+	result := make(map[K]Set[T])
+	for value := range set.mp {
+		key := classifier(value)
+		group, ok := result[key]
+		if !ok {
+			group = NewSet[T]()
+			result[key] = group
+		}
+		group.mp[value] = struct{}{}
+	}
+	return result
+}
+
+// Partition splits the set into two new sets: the first contains the elements that satisfy the condition,
+// the second contains the rest of the elements.
+//   - match - the function that is applied to each element to determine the set it belongs to
+func Partition[T comparable](set Set[T], match func(value T) bool) (Set[T], Set[T]) {
+	matched, rest := NewSet[T](), NewSet[T]()
+	for value := range set.mp {
+		if match(value) {
+			matched.mp[value] = struct{}{}
+		} else {
+			rest.mp[value] = struct{}{}
+		}
+	}
+	return matched, rest
+}
+
+// AnyMatch returns true if at least one element of the set satisfies the condition.
+// Returns false if the set is empty.
+//   - match - the function that is applied to the elements
+func AnyMatch[T comparable](set Set[T], match func(value T) bool) bool {
+	for value := range set.mp {
+		if match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if all elements of the set satisfy the condition.
+// Returns true if the set is empty.
+//   - match - the function that is applied to the elements
+func AllMatch[T comparable](set Set[T], match func(value T) bool) bool {
+	for value := range set.mp {
+		if !match(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch returns true if no elements of the set satisfy the condition.
+// Returns true if the set is empty.
+//   - match - the function that is applied to the elements
+func NoneMatch[T comparable](set Set[T], match func(value T) bool) bool {
+	return !AnyMatch(set, match)
+}
+
+// Count returns the number of elements of the set that satisfy the condition.
+//   - match - the function that is applied to each element
+func Count[T comparable](set Set[T], match func(value T) bool) int {
+	count := 0
+	for value := range set.mp {
+		if match(value) {
+			count++
+		}
+	}
+	return count
+}
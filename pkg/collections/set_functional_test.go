@@ -0,0 +1,102 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	set := NewSetItems(-2, -1, 0, 1, 2)
+	actual := sortedSlice(Map(set, func(value int) int { return value * value }))
+	want := []int{0, 1, 4}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Map() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	set := NewSetItems(1, 3)
+	actual := sortedSlice(FlatMap(set, func(value int) []int { return []int{value, value + 1} }))
+	want := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("FlatMap() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	set := NewSetItems(1, 2, 3, 4, 5)
+	actual := sortedSlice(Filter(set, func(value int) bool { return value%2 == 0 }))
+	want := []int{2, 4}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Filter() got: %v, want: %v", actual, want)
+	}
+	if set.Size() != 5 {
+		t.Fatal("the source set was changed")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	set := NewSetItems(1, 2, 3, 4)
+	if actual := Reduce(set, 10, func(acc, value int) int { return acc + value }); actual != 20 {
+		t.Fatalf("Reduce() got: %d, want: %d", actual, 20)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	set := NewSetItems(1, 2, 3, 4, 5)
+	groups := GroupBy(set, func(value int) bool { return value%2 == 0 })
+	if actual := sortedSlice(groups[true]); !reflect.DeepEqual(actual, []int{2, 4}) {
+		t.Fatalf("GroupBy() even: %v, want: %v", actual, []int{2, 4})
+	}
+	if actual := sortedSlice(groups[false]); !reflect.DeepEqual(actual, []int{1, 3, 5}) {
+		t.Fatalf("GroupBy() odd: %v, want: %v", actual, []int{1, 3, 5})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	set := NewSetItems(1, 2, 3, 4, 5)
+	matched, rest := Partition(set, func(value int) bool { return value > 3 })
+	if actual := sortedSlice(matched); !reflect.DeepEqual(actual, []int{4, 5}) {
+		t.Fatalf("Partition() matched: %v, want: %v", actual, []int{4, 5})
+	}
+	if actual := sortedSlice(rest); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("Partition() rest: %v, want: %v", actual, []int{1, 2, 3})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	positive := func(value int) bool { return value > 0 }
+	tests := []struct {
+		set       Set[int]
+		wantAny   bool
+		wantAll   bool
+		wantNone  bool
+		wantCount int
+	}{
+		{NewSet[int](), false, true, true, 0},
+		{NewSetItems(1, 2), true, true, false, 2},
+		{NewSetItems(-1, 2, 3), true, false, false, 2},
+		{NewSetItems(-1, 0), false, false, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(sortedSlice(tt.set)), func(t *testing.T) {
+			if got := AnyMatch(tt.set, positive); got != tt.wantAny {
+				t.Errorf("AnyMatch() = %t, want %t", got, tt.wantAny)
+			}
+			if got := AllMatch(tt.set, positive); got != tt.wantAll {
+				t.Errorf("AllMatch() = %t, want %t", got, tt.wantAll)
+			}
+			if got := NoneMatch(tt.set, positive); got != tt.wantNone {
+				t.Errorf("NoneMatch() = %t, want %t", got, tt.wantNone)
+			}
+			if got := Count(tt.set, positive); got != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
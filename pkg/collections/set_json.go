@@ -0,0 +1,77 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+var (
+	// ErrDuplicateValue error: 'duplicate value'
+	ErrDuplicateValue = errors.New("duplicate value")
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Set is encoded as a JSON array of its elements in an unspecified order.
+// Use MarshalJSONSorted to get a deterministic output.
+func (set Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.ToSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The Set is decoded from a JSON array, replacing the current contents of the Set.
+// Duplicate elements of the array are merged into one element of the Set;
+// use UnmarshalJSONStrict to reject such input. JSON null is decoded as an empty Set.
+func (set *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	set.Clear()
+	set.AddAll(values...)
+	return nil
+}
+
+// MarshalJSONSorted returns the JSON encoding of the set as an array of its elements sorted in ascending order,
+// so equal sets always have the same encoding.
+func MarshalJSONSorted[T cmp.Ordered](set Set[T]) ([]byte, error) {
+	values := set.ToSlice()
+	slices.Sort(values)
+	return json.Marshal(values)
+}
+
+// UnmarshalJSONStrict decodes a JSON array into the set like Set.UnmarshalJSON,
+// but returns an error wrapping ErrDuplicateValue if the array contains duplicate elements.
+// The set is not changed if an error is returned.
+func UnmarshalJSONStrict[T comparable](data []byte, set *Set[T]) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	decoded := NewSetCapacity[T](set.capacity)
+	for _, value := range values {
+		if !decoded.Add(value) {
+			return &duplicateValueError[T]{value: value}
+		}
+	}
+	set.mp = decoded.mp
+	return nil
+}
+
+type duplicateValueError[T any] struct {
+	value T
+}
+
+func (err *duplicateValueError[T]) Error() string {
+	data, _ := json.Marshal(err.value)
+	return ErrDuplicateValue.Error() + ": " + string(data)
+}
+
+func (err *duplicateValueError[T]) Unwrap() error {
+	return ErrDuplicateValue
+}
@@ -0,0 +1,108 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSet_MarshalJSON(t *testing.T) {
+	type payload struct {
+		Tags    Set[string]  `json:"tags"`
+		Numbers *Set[int]    `json:"numbers"`
+		Empty   Set[float64] `json:"empty"`
+	}
+	numbers := NewSetItems(1, 2, 3)
+	data, err := json.Marshal(payload{Tags: NewSetItems("a"), Numbers: &numbers, Empty: NewSet[float64]()})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var decoded payload
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !decoded.Tags.Equal(NewSetItems("a")) {
+		t.Fatalf("unexpected tags: %v", decoded.Tags.ToSlice())
+	}
+	if decoded.Numbers == nil || !decoded.Numbers.Equal(numbers) {
+		t.Fatalf("unexpected numbers: %v", decoded.Numbers)
+	}
+	if decoded.Empty.Size() != 0 {
+		t.Fatalf("unexpected empty set: %v", decoded.Empty.ToSlice())
+	}
+}
+
+func TestMarshalJSONSorted(t *testing.T) {
+	data, err := MarshalJSONSorted(NewSetItems(10, 9, 1, 5))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if string(data) != "[1,5,9,10]" {
+		t.Fatalf("MarshalJSONSorted() got: %s, want: %s", data, "[1,5,9,10]")
+	}
+	if data, _ = MarshalJSONSorted(NewSet[string]()); string(data) != "[]" {
+		t.Fatalf("MarshalJSONSorted() got: %s, want: %s", data, "[]")
+	}
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []int
+		wantErr bool
+	}{
+		{"empty", "[]", []int{}, false},
+		{"null", "null", []int{}, false},
+		{"values", "[3,1,2]", []int{1, 2, 3}, false},
+		{"duplicates", "[1,1,2,2]", []int{1, 2}, false},
+		{"invalid", `["a"]`, []int{7}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := NewSetItems(7)
+			err := json.Unmarshal([]byte(tt.data), &set)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if actual := sortedSlice(set); !reflect.DeepEqual(actual, tt.want) {
+				t.Errorf("UnmarshalJSON() got: %v, want: %v", actual, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_UnmarshalJSON_zero(t *testing.T) {
+	var set Set[string]
+	if err := set.UnmarshalJSON([]byte(`["a","b"]`)); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if !set.Equal(NewSetItems("a", "b")) {
+		t.Fatalf("UnmarshalJSON() got: %v", set.ToSlice())
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	set := NewSetItems(7)
+	err := UnmarshalJSONStrict([]byte("[1,2,1]"), &set)
+	if !errors.Is(err, ErrDuplicateValue) {
+		t.Fatalf("expected error: '%v', got: '%v'", ErrDuplicateValue, err)
+	}
+	if err.Error() != "duplicate value: 1" {
+		t.Fatalf("unexpected error message: '%s'", err.Error())
+	}
+	if actual := sortedSlice(set); !reflect.DeepEqual(actual, []int{7}) {
+		t.Fatalf("the set was changed: %v", actual)
+	}
+	if err = UnmarshalJSONStrict([]byte("[1,2]"), &set); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if actual := sortedSlice(set); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Fatalf("UnmarshalJSONStrict() got: %v, want: %v", actual, []int{1, 2})
+	}
+}
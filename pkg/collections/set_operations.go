@@ -0,0 +1,157 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+// Union returns a new Set containing all the elements that are contained in at least one of the specified sets.
+func Union[T comparable](a, b Set[T]) Set[T] {
+	larger, smaller := largerSmaller(a, b)
+	result := Set[T]{mp: CopyMap(larger.mp)}
+	result.AddSet(smaller)
+	return result
+}
+
+// Intersection returns a new Set containing only the elements that are contained in both specified sets.
+func Intersection[T comparable](a, b Set[T]) Set[T] {
+	larger, smaller := largerSmaller(a, b)
+	result := NewSet[T]()
+	for value := range smaller.mp {
+		if _, ok := larger.mp[value]; ok {
+			result.mp[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new Set containing the elements of the set a that are not contained in the set b.
+// If the set b is smaller, the set a is copied and the elements of the set b are removed from the copy,
+// otherwise the elements of the set a are looked up in the set b.
+func Difference[T comparable](a, b Set[T]) Set[T] {
+	if len(b.mp) < len(a.mp) {
+		result := Set[T]{mp: CopyMap(a.mp)}
+		for value := range b.mp {
+			delete(result.mp, value)
+		}
+		return result
+	}
+	result := NewSet[T]()
+	for value := range a.mp {
+		if _, ok := b.mp[value]; !ok {
+			result.mp[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new Set containing the elements that are contained in exactly one of the specified sets.
+func SymmetricDifference[T comparable](a, b Set[T]) Set[T] {
+	result := Difference(a, b)
+	for value := range b.mp {
+		if _, ok := a.mp[value]; !ok {
+			result.mp[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// AddSet adds all the elements of the other set to this Set.
+// Returns true if this Set changed as result of the call.
+func (set *Set[T]) AddSet(other Set[T]) bool {
+	var changed bool
+	for value := range other.mp {
+		if _, ok := set.mp[value]; !ok {
+			set.mp[value] = struct{}{}
+			changed = true
+		}
+	}
+	return changed
+}
+
+// RetainAll retains only the elements of this Set that are contained in the other set.
+// If the other set is smaller, the intersection is built from its elements and replaces the contents of this Set.
+// Returns true if this Set changed as result of the call.
+func (set *Set[T]) RetainAll(other Set[T]) bool {
+	if len(other.mp) < len(set.mp) {
+		mp := make(map[T]struct{}, len(other.mp))
+		for value := range other.mp {
+			if _, ok := set.mp[value]; ok {
+				mp[value] = struct{}{}
+			}
+		}
+		set.mp = mp
+		// the intersection is smaller than this Set, so this Set always changes
+		return true
+	}
+	var changed bool
+	for value := range set.mp {
+		if _, ok := other.mp[value]; !ok {
+			delete(set.mp, value)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// RemoveAll removes from this Set all the elements that are contained in the other set.
+// Returns true if this Set changed as result of the call.
+func (set *Set[T]) RemoveAll(other Set[T]) bool {
+	var changed bool
+	if len(other.mp) < len(set.mp) {
+		for value := range other.mp {
+			if _, ok := set.mp[value]; ok {
+				delete(set.mp, value)
+				changed = true
+			}
+		}
+		return changed
+	}
+	for value := range set.mp {
+		if _, ok := other.mp[value]; ok {
+			delete(set.mp, value)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// IsSubsetOf returns true if all the elements of this Set are contained in the other set.
+func (set *Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(set.mp) > len(other.mp) {
+		return false
+	}
+	for value := range set.mp {
+		if _, ok := other.mp[value]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if this Set contains all the elements of the other set.
+func (set *Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(*set)
+}
+
+// IsDisjoint returns true if this Set and the other set have no elements in common.
+func (set *Set[T]) IsDisjoint(other Set[T]) bool {
+	larger, smaller := largerSmaller(*set, other)
+	for value := range smaller.mp {
+		if _, ok := larger.mp[value]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if this Set and the other set contain the same elements.
+func (set *Set[T]) Equal(other Set[T]) bool {
+	return len(set.mp) == len(other.mp) && set.IsSubsetOf(other)
+}
+
+func largerSmaller[T comparable](a, b Set[T]) (Set[T], Set[T]) {
+	if len(a.mp) < len(b.mp) {
+		return b, a
+	}
+	return a, b
+}
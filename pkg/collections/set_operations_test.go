@@ -0,0 +1,152 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortedSlice(set Set[int]) []int {
+	result := set.ToSlice()
+	sort.Ints(result)
+	return result
+}
+
+func TestSetOperations(t *testing.T) {
+	type testCase struct {
+		name      string
+		operation func(a, b Set[int]) Set[int]
+		a, b      Set[int]
+		want      []int
+	}
+	tests := []testCase{
+		{"union", Union[int], NewSetItems(1, 2, 3), NewSetItems(3, 4), []int{1, 2, 3, 4}},
+		{"union empty", Union[int], NewSet[int](), NewSetItems(1), []int{1}},
+		{"intersection", Intersection[int], NewSetItems(1, 2, 3), NewSetItems(2, 3, 4, 5), []int{2, 3}},
+		{"intersection disjoint", Intersection[int], NewSetItems(1, 2), NewSetItems(3), []int{}},
+		{"difference", Difference[int], NewSetItems(1, 2, 3), NewSetItems(2, 4), []int{1, 3}},
+		{"difference reverse", Difference[int], NewSetItems(2, 4), NewSetItems(1, 2, 3), []int{4}},
+		{"difference smaller b", Difference[int], NewSetItems(1, 2, 3, 4), NewSetItems(4, 5), []int{1, 2, 3}},
+		{"difference empty b", Difference[int], NewSetItems(1, 2), NewSet[int](), []int{1, 2}},
+		{"symmetric difference", SymmetricDifference[int], NewSetItems(1, 2, 3), NewSetItems(2, 4), []int{1, 3, 4}},
+		{"symmetric difference equal", SymmetricDifference[int], NewSetItems(1, 2), NewSetItems(2, 1), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aBefore, bBefore := sortedSlice(tt.a), sortedSlice(tt.b)
+			got := sortedSlice(tt.operation(tt.a, tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(sortedSlice(tt.a), aBefore) || !reflect.DeepEqual(sortedSlice(tt.b), bBefore) {
+				t.Error("the source sets were changed")
+			}
+		})
+	}
+}
+
+func TestSet_AddSet(t *testing.T) {
+	set := NewSetItems(1, 2)
+	if !set.AddSet(NewSetItems(2, 3)) {
+		t.Fatal("the set was not changed")
+	}
+	if set.AddSet(NewSetItems(1, 3)) {
+		t.Fatal("the set was changed when trying to add duplicate values")
+	}
+	if got := sortedSlice(set); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("AddSet() got: %v, want: %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestSet_RetainAll(t *testing.T) {
+	type testCase struct {
+		name        string
+		set         Set[int]
+		other       Set[int]
+		wantChanged bool
+		want        []int
+	}
+	tests := []testCase{
+		{"smaller other", NewSetItems(1, 2, 3, 4), NewSetItems(2, 4, 6), true, []int{2, 4}},
+		{"larger other", NewSetItems(1, 2), NewSetItems(2, 3, 4, 5), true, []int{2}},
+		{"equal", NewSetItems(2, 4), NewSetItems(2, 4), false, []int{2, 4}},
+		{"superset other", NewSetItems(1, 2), NewSetItems(1, 2, 3), false, []int{1, 2}},
+		{"empty other", NewSetItems(1), NewSet[int](), true, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changed := tt.set.RetainAll(tt.other); changed != tt.wantChanged {
+				t.Errorf("RetainAll() changed: %t, want: %t", changed, tt.wantChanged)
+			}
+			if got := sortedSlice(tt.set); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RetainAll() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_RemoveAll(t *testing.T) {
+	type testCase struct {
+		name        string
+		set         Set[int]
+		other       Set[int]
+		wantChanged bool
+		want        []int
+	}
+	tests := []testCase{
+		{"smaller other", NewSetItems(1, 2, 3, 4), NewSetItems(2, 5), true, []int{1, 3, 4}},
+		{"larger other", NewSetItems(1, 2), NewSetItems(2, 3, 4, 5), true, []int{1}},
+		{"disjoint", NewSetItems(1, 2), NewSetItems(3), false, []int{1, 2}},
+		{"empty other", NewSetItems(1), NewSet[int](), false, []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changed := tt.set.RemoveAll(tt.other); changed != tt.wantChanged {
+				t.Errorf("RemoveAll() changed: %t, want: %t", changed, tt.wantChanged)
+			}
+			if got := sortedSlice(tt.set); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RemoveAll() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_predicates(t *testing.T) {
+	type testCase struct {
+		name     string
+		a, b     Set[int]
+		subset   bool
+		superset bool
+		disjoint bool
+		equal    bool
+	}
+	tests := []testCase{
+		{"empty", NewSet[int](), NewSet[int](), true, true, true, true},
+		{"empty and not empty", NewSet[int](), NewSetItems(1), true, false, true, false},
+		{"subset", NewSetItems(1, 2), NewSetItems(1, 2, 3), true, false, false, false},
+		{"superset", NewSetItems(1, 2, 3), NewSetItems(3), false, true, false, false},
+		{"equal", NewSetItems(1, 2, 3), NewSetItems(3, 2, 1), true, true, false, true},
+		{"disjoint", NewSetItems(1, 2), NewSetItems(3, 4), false, false, true, false},
+		{"overlapping", NewSetItems(1, 2), NewSetItems(2, 3), false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsSubsetOf(tt.b); got != tt.subset {
+				t.Errorf("IsSubsetOf() = %t, want %t", got, tt.subset)
+			}
+			if got := tt.a.IsSupersetOf(tt.b); got != tt.superset {
+				t.Errorf("IsSupersetOf() = %t, want %t", got, tt.superset)
+			}
+			if got := tt.a.IsDisjoint(tt.b); got != tt.disjoint {
+				t.Errorf("IsDisjoint() = %t, want %t", got, tt.disjoint)
+			}
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("Equal() = %t, want %t", got, tt.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PavloVM7/go-collections/pkg/collections/internal/codec"
+)
+
+var (
+	// ErrInvalidArray error: 'invalid array literal'
+	ErrInvalidArray = errors.New("invalid array literal")
+)
+
+// JSONColumn is an adapter that stores a Set in a database column as a JSON array.
+// It implements the sql.Scanner and driver.Valuer interfaces.
+//   - T - value type
+type JSONColumn[T comparable] struct {
+	set *Set[T]
+}
+
+// Value implements the driver.Valuer interface.
+func (column JSONColumn[T]) Value() (driver.Value, error) {
+	data, err := column.set.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// A NULL value is scanned as an empty Set.
+func (column JSONColumn[T]) Scan(src any) error {
+	if src == nil {
+		column.set.Clear()
+		return nil
+	}
+	data, err := sqlBytes(src)
+	if err != nil {
+		return err
+	}
+	return column.set.UnmarshalJSON(data)
+}
+
+// NewJSONColumn returns an adapter that stores the set in a database column as a JSON array,
+// e.g. '["a","b","c"]'.
+//   - set - the set to be stored or scanned into
+func NewJSONColumn[T comparable](set *Set[T]) JSONColumn[T] {
+	return JSONColumn[T]{set: set}
+}
+
+// ArrayColumn is an adapter that stores a Set in a database column as a PostgreSQL array literal.
+// It implements the sql.Scanner and driver.Valuer interfaces.
+// The elements must implement encoding.TextMarshaler and encoding.TextUnmarshaler
+// or be of a string, boolean, integer or floating-point kind.
+//   - T - value type
+type ArrayColumn[T comparable] struct {
+	set *Set[T]
+}
+
+// Value implements the driver.Valuer interface.
+func (column ArrayColumn[T]) Value() (driver.Value, error) {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	first := true
+	for value := range column.set.All() {
+		text, err := codec.ValueToText(value)
+		if err != nil {
+			return nil, err
+		}
+		if !first {
+			sb.WriteByte(',')
+		}
+		first = false
+		writeArrayElement(&sb, text)
+	}
+	sb.WriteByte('}')
+	return sb.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// A NULL value is scanned as an empty Set. The Set is not changed if an error is returned.
+func (column ArrayColumn[T]) Scan(src any) error {
+	if src == nil {
+		column.set.Clear()
+		return nil
+	}
+	data, err := sqlBytes(src)
+	if err != nil {
+		return err
+	}
+	elements, err := parseArray(string(data))
+	if err != nil {
+		return err
+	}
+	decoded := NewSetCapacity[T](column.set.capacity)
+	for _, text := range elements {
+		var value T
+		if err = codec.TextToValue(text, &value); err != nil {
+			return err
+		}
+		decoded.mp[value] = struct{}{}
+	}
+	column.set.mp = decoded.mp
+	return nil
+}
+
+// NewArrayColumn returns an adapter that stores the set in a database column as a PostgreSQL array literal,
+// e.g. '{a,b,"c d"}'.
+//   - set - the set to be stored or scanned into
+func NewArrayColumn[T comparable](set *Set[T]) ArrayColumn[T] {
+	return ArrayColumn[T]{set: set}
+}
+
+func sqlBytes(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported source type %T", src)
+	}
+}
+
+func writeArrayElement(sb *strings.Builder, text string) {
+	if text != "" && !strings.EqualFold(text, "NULL") && !strings.ContainsAny(text, "{}\",\\ \t\n\r\v\f") {
+		sb.WriteString(text)
+		return
+	}
+	sb.WriteByte('"')
+	for _, r := range text {
+		if r == '"' || r == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	sb.WriteByte('"')
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal and returns its elements.
+//
+//revive:disable:cognitive-complexity
+//revive:disable:cyclomatic
+func parseArray(literal string) ([]string, error) {
+	literal = strings.TrimSpace(literal)
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil, ErrInvalidArray
+	}
+	body := literal[1 : len(literal)-1]
+	elements := make([]string, 0)
+	if strings.TrimSpace(body) == "" {
+		return elements, nil
+	}
+	for i := 0; ; {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		var element strings.Builder
+		if i < len(body) && body[i] == '"' {
+			i++
+			closed := false
+			for ; i < len(body); i++ {
+				c := body[i]
+				if c == '\\' && i+1 < len(body) {
+					i++
+					element.WriteByte(body[i])
+				} else if c == '"' {
+					closed = true
+					i++
+					break
+				} else {
+					element.WriteByte(c)
+				}
+			}
+			if !closed {
+				return nil, ErrInvalidArray
+			}
+			for i < len(body) && body[i] == ' ' {
+				i++
+			}
+		} else {
+			start := i
+			for i < len(body) && body[i] != ',' {
+				if strings.IndexByte("{}\"\\", body[i]) >= 0 {
+					return nil, ErrInvalidArray
+				}
+				i++
+			}
+			text := strings.TrimSpace(body[start:i])
+			if text == "" {
+				return nil, ErrInvalidArray
+			}
+			if strings.EqualFold(text, "NULL") {
+				return nil, fmt.Errorf("%w: NULL elements are not supported", ErrInvalidArray)
+			}
+			element.WriteString(text)
+		}
+		elements = append(elements, element.String())
+		if i == len(body) {
+			return elements, nil
+		}
+		if body[i] != ',' {
+			return nil, ErrInvalidArray
+		}
+		i++
+	}
+}
+
+//revive:enable:cyclomatic
+//revive:enable:cognitive-complexity
@@ -0,0 +1,193 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is an in-memory database driver that stores the single argument of an Exec call
+// and returns the stored value as the single column of a single row on Query.
+type fakeDriver struct {
+	mu    sync.Mutex
+	value driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{driver: c.driver}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ driver *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+	s.driver.value = args[0]
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+	return &fakeRows{value: s.driver.value}, nil
+}
+
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+var fakeDriverInstance = &fakeDriver{}
+
+func init() {
+	sql.Register("collections-fake", fakeDriverInstance)
+}
+
+func TestSQLColumns(t *testing.T) {
+	db, err := sql.Open("collections-fake", "")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	defer db.Close()
+	type adapter interface {
+		driver.Valuer
+		sql.Scanner
+	}
+	tests := []struct {
+		name    string
+		adapter func(set *Set[string]) adapter
+	}{
+		{"json", func(set *Set[string]) adapter { return NewJSONColumn(set) }},
+		{"array", func(set *Set[string]) adapter { return NewArrayColumn(set) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewSetItems("a", "b c", `d"e`, "f,g", "", "NULL", `h\i`)
+			if _, err := db.Exec("INSERT", tt.adapter(&source)); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			decoded := NewSetItems("old")
+			if err := db.QueryRow("SELECT").Scan(tt.adapter(&decoded)); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if !decoded.Equal(source) {
+				t.Fatalf("got: %q, want: %q", decoded, source)
+			}
+			if _, err := db.Exec("INSERT", nil); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if err := db.QueryRow("SELECT").Scan(tt.adapter(&decoded)); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if !decoded.IsEmpty() {
+				t.Fatalf("NULL must be scanned as an empty set, got: %q", decoded)
+			}
+		})
+	}
+}
+
+func TestArrayColumn_Value(t *testing.T) {
+	tests := []struct {
+		name string
+		set  Set[string]
+		want string
+	}{
+		{"empty", NewSet[string](), "{}"},
+		{"plain", NewSetItems("abc"), "{abc}"},
+		{"empty string", NewSetItems(""), `{""}`},
+		{"null", NewSetItems("null"), `{"null"}`},
+		{"special", NewSetItems(`a "b" \c`), `{"a \"b\" \\c"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := NewArrayColumn(&tt.set).Value()
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if value != tt.want {
+				t.Errorf("Value() got: %v, want: %v", value, tt.want)
+			}
+		})
+	}
+}
+
+func TestArrayColumn_Scan(t *testing.T) {
+	tests := []struct {
+		src     any
+		want    []int
+		wantErr bool
+	}{
+		{"{}", []int{}, false},
+		{[]byte("{1,2,3}"), []int{1, 2, 3}, false},
+		{`{ 1 , "2" ,3 }`, []int{1, 2, 3}, false},
+		{"{1,1}", []int{1}, false},
+		{"1,2", nil, true},
+		{"{1,}", nil, true},
+		{"{1,NULL}", nil, true},
+		{`{"1}`, nil, true},
+		{"{{1},{2}}", nil, true},
+		{"{a}", nil, true},
+		{12, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmtSrc(tt.src), func(t *testing.T) {
+			set := NewSetItems(7)
+			err := NewArrayColumn(&set).Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			want := tt.want
+			if tt.wantErr {
+				want = []int{7}
+			}
+			if actual := sortedSlice(set); !reflect.DeepEqual(actual, want) {
+				t.Errorf("Scan() got: %v, want: %v", actual, want)
+			}
+		})
+	}
+}
+
+func TestJSONColumn_Scan_fail(t *testing.T) {
+	set := NewSet[int]()
+	if err := NewJSONColumn(&set).Scan(1.5); err == nil {
+		t.Fatal("an error is expected")
+	}
+	if err := NewJSONColumn(&set).Scan(`{"a":1}`); err == nil {
+		t.Fatal("an error is expected")
+	}
+}
+
+func fmtSrc(src any) string {
+	switch v := src.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return "other"
+	}
+}
@@ -6,35 +6,117 @@ package collections
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	"runtime"
 	"sort"
 	"testing"
 )
 
-func TestSet_ToSlice(t *testing.T) {
-	expected := []int{1, 2, 3, 4, 5}
-	set := NewSetItems[int](expected...)
-	array := set.ToSlice()
-	sort.Ints(array)
-	if !reflect.DeepEqual(array, expected) {
-		t.Fatalf("\nexpected: %v\n  actual: %v", expected, array)
-	}
+// testSet is the method set shared by all the set implementations,
+// so the same tests are run against each of them.
+type testSet[T comparable] interface {
+	Add(value T) bool
+	AddAll(values ...T) bool
+	Contains(value T) bool
+	Remove(value T) bool
+	Size() int
+	IsEmpty() bool
+	TrimToSize()
+	Clear()
+	Capacity() int
+	ToSlice() []T
+	All() iter.Seq[T]
 }
 
-func TestNewSet(t *testing.T) {
-	set := NewSet[int]()
-	if set.Size() != 0 {
-		t.Fatalf("invalid size, expected: %d, actual: %d", 0, set.Size())
-	}
-	if !set.IsEmpty() {
-		t.Fatal("the set isn't empty")
+type setImplementation[T comparable] struct {
+	name        string
+	newCapacity func(capacity int) testSet[T]
+}
+
+func (impl setImplementation[T]) new() testSet[T] {
+	return impl.newCapacity(0)
+}
+
+func (impl setImplementation[T]) items(values ...T) testSet[T] {
+	set := impl.newCapacity(len(values))
+	set.AddAll(values...)
+	return set
+}
+
+func setImplementations[T comparable]() []setImplementation[T] {
+	return []setImplementation[T]{
+		{"Set", func(capacity int) testSet[T] {
+			set := NewSetCapacity[T](capacity)
+			return &set
+		}},
+		{"ConcurrentSet", func(capacity int) testSet[T] { return NewConcurrentSetCapacity[T](capacity) }},
+		{"ShardedSet", func(capacity int) testSet[T] { return NewShardedSetCapacity[T](0, capacity) }},
+		{"OrderedSet", func(capacity int) testSet[T] { return NewOrderedSetCapacity[T](capacity) }},
 	}
-	if set.Capacity() != 0 {
-		t.Fatalf("invalid capacity, expected: %d, actual: %d", 0, set.Capacity())
+}
+
+// runSetTest runs the test against every set implementation
+func runSetTest[T comparable](t *testing.T, test func(t *testing.T, impl setImplementation[T])) {
+	for _, impl := range setImplementations[T]() {
+		t.Run(impl.name, func(t *testing.T) {
+			test(t, impl)
+		})
 	}
 }
 
+func TestSet_ToSlice(t *testing.T) {
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		expected := []int{1, 2, 3, 4, 5}
+		set := impl.items(expected...)
+		array := set.ToSlice()
+		sort.Ints(array)
+		if !reflect.DeepEqual(array, expected) {
+			t.Fatalf("\nexpected: %v\n  actual: %v", expected, array)
+		}
+	})
+}
+
+func TestSet_All(t *testing.T) {
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		expected := []int{1, 2, 3, 4, 5}
+		set := impl.items(expected...)
+		actual := make([]int, 0, set.Size())
+		for v := range set.All() {
+			actual = append(actual, v)
+		}
+		sort.Ints(actual)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("\nexpected: %v\n  actual: %v", expected, actual)
+		}
+		count := 0
+		for range set.All() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Fatalf("the iteration was not stopped, count: %d", count)
+		}
+	})
+}
+
+func TestNewSet(t *testing.T) {
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		set := impl.new()
+		if set.Size() != 0 {
+			t.Fatalf("invalid size, expected: %d, actual: %d", 0, set.Size())
+		}
+		if !set.IsEmpty() {
+			t.Fatal("the set isn't empty")
+		}
+		if set.Capacity() != 0 {
+			t.Fatalf("invalid capacity, expected: %d, actual: %d", 0, set.Capacity())
+		}
+	})
+}
+
 func TestNewSetCapacity(t *testing.T) {
 	type args struct {
 		capacity int
@@ -59,240 +141,267 @@ func TestNewSetCapacity(t *testing.T) {
 }
 
 func TestSet_Add(t *testing.T) {
-	set := NewSet[int]()
-	values := []int{1, 2, 3}
-	for _, v := range values {
-		added := set.Add(v)
-		if !added {
-			t.Fatalf("value %v was not added to the set", v)
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		set := impl.new()
+		values := []int{1, 2, 3}
+		for _, v := range values {
+			added := set.Add(v)
+			if !added {
+				t.Fatalf("value %v was not added to the set", v)
+			}
 		}
-	}
-	if set.Size() != len(values) {
-		t.Fatalf("invalid set size, expected: %v, actual: %v", len(values), set.Size())
-	}
-	for _, v := range values {
-		added := set.Add(v)
-		if added {
-			t.Fatalf("dublicate value %v was added to the set", v)
+		if set.Size() != len(values) {
+			t.Fatalf("invalid set size, expected: %v, actual: %v", len(values), set.Size())
 		}
-	}
-	if set.Size() != len(values) {
-		t.Fatalf("invalid set size, expected: %v, actual: %v", len(values), set.Size())
-	}
+		for _, v := range values {
+			added := set.Add(v)
+			if added {
+				t.Fatalf("dublicate value %v was added to the set", v)
+			}
+		}
+		if set.Size() != len(values) {
+			t.Fatalf("invalid set size, expected: %v, actual: %v", len(values), set.Size())
+		}
+	})
 }
 
 func TestSet_AddAll(t *testing.T) {
-	set := NewSet[string]()
-	values := []string{"string 1", "string 2", "string 3"}
-	values2 := []string{"string 4", "string 5"}
+	runSetTest(t, func(t *testing.T, impl setImplementation[string]) {
+		set := impl.new()
+		values := []string{"string 1", "string 2", "string 3"}
+		values2 := []string{"string 4", "string 5"}
 
-	changed := set.AddAll(values...)
-	if !changed {
-		t.Fatalf("the set was not changed")
-	}
-	if set.Size() != len(values) {
-		t.Fatalf("invalid size, expected: %d, actual: %d", len(values), set.Size())
-	}
-	changed = set.AddAll(values...)
-	if changed {
-		t.Fatalf("the set was changed when trying to add duplicate values")
-	}
-	changed = set.AddAll(values2...)
-	if !changed {
-		t.Fatalf("the set was not changed")
-	}
-	expectedSize := len(values) + len(values2)
-	if set.Size() != expectedSize {
-		t.Fatalf("invalid size, expected: %d, actual: %d", expectedSize, set.Size())
-	}
-	changed = set.AddAll(values2...)
-	if changed {
-		t.Fatalf("the set was changed when trying to add duplicate values")
-	}
+		changed := set.AddAll(values...)
+		if !changed {
+			t.Fatalf("the set was not changed")
+		}
+		if set.Size() != len(values) {
+			t.Fatalf("invalid size, expected: %d, actual: %d", len(values), set.Size())
+		}
+		changed = set.AddAll(values...)
+		if changed {
+			t.Fatalf("the set was changed when trying to add duplicate values")
+		}
+		changed = set.AddAll(values2...)
+		if !changed {
+			t.Fatalf("the set was not changed")
+		}
+		expectedSize := len(values) + len(values2)
+		if set.Size() != expectedSize {
+			t.Fatalf("invalid size, expected: %d, actual: %d", expectedSize, set.Size())
+		}
+		changed = set.AddAll(values2...)
+		if changed {
+			t.Fatalf("the set was changed when trying to add duplicate values")
+		}
+	})
 }
 
 func TestSet_Capacity(t *testing.T) {
-	type testCase[T comparable] struct {
-		name string
-		set  Set[T]
-		want int
-	}
-	tests := []testCase[int]{
-		{"empty", NewSet[int](), 0},
-		{"capacity", NewSetCapacity[int](123), 123},
-		{"capacity less then zero", NewSetCapacity[int](-1), -1},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.set.Capacity(); got != tt.want {
-				t.Errorf("Capacity() = %v, want %v", got, tt.want)
-			}
-		})
-	}
+	type testCase struct {
+		name     string
+		capacity int
+		want     int
+	}
+	tests := []testCase{
+		{"empty", 0, 0},
+		{"capacity", 123, 123},
+		{"capacity less then zero", -1, -1},
+	}
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := impl.newCapacity(tt.capacity).Capacity(); got != tt.want {
+					t.Errorf("Capacity() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
 }
 
 func TestSet_Clear(t *testing.T) {
-	type testCase[T comparable] struct {
-		name string
-		set  Set[T]
-		want int
-	}
-	tests := []testCase[string]{
-		{"empty", NewSet[string](), 0},
-		{"one", NewSetItems[string]("one"), 1},
-		{"three", NewSetItems[string]("one", "two", "three"), 3},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if tt.set.Size() != tt.want {
-				t.Fatalf("expected: %d, actual: %d", tt.want, tt.set.Size())
-			}
-			tt.set.Clear()
-			if tt.set.Size() != 0 {
-				t.Fatalf("the set was not cleared, size: %d", tt.set.Size())
-			}
-		})
-	}
+	type testCase struct {
+		name   string
+		values []string
+	}
+	tests := []testCase{
+		{"empty", nil},
+		{"one", []string{"one"}},
+		{"three", []string{"one", "two", "three"}},
+	}
+	runSetTest(t, func(t *testing.T, impl setImplementation[string]) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				set := impl.items(tt.values...)
+				if set.Size() != len(tt.values) {
+					t.Fatalf("expected: %d, actual: %d", len(tt.values), set.Size())
+				}
+				set.Clear()
+				if set.Size() != 0 {
+					t.Fatalf("the set was not cleared, size: %d", set.Size())
+				}
+				if !set.Add("after clear") {
+					t.Fatal("a value was not added to the cleared set")
+				}
+			})
+		}
+	})
 }
 
 func TestSet_IsEmpty(t *testing.T) {
-	type testCase[T comparable] struct {
-		name string
-		set  Set[T]
-		want bool
-	}
-	tests := []testCase[string]{
-		{"empty", NewSet[string](), true},
-		{"empty with capacity", NewSetCapacity[string](17), true},
-		{"one", NewSetItems[string]("string 1"), false},
-		{"three", NewSetItems[string]("string 1", "two string", "three"), false},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.set.IsEmpty(); got != tt.want {
-				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
-			}
-		})
-	}
+	type testCase struct {
+		name     string
+		capacity int
+		values   []string
+		want     bool
+	}
+	tests := []testCase{
+		{"empty", 0, nil, true},
+		{"empty with capacity", 17, nil, true},
+		{"one", 0, []string{"string 1"}, false},
+		{"three", 0, []string{"string 1", "two string", "three"}, false},
+	}
+	runSetTest(t, func(t *testing.T, impl setImplementation[string]) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				set := impl.newCapacity(tt.capacity)
+				set.AddAll(tt.values...)
+				if got := set.IsEmpty(); got != tt.want {
+					t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
 }
 
 func TestSet_Remove(t *testing.T) {
-	set := NewSet[int]()
-	values := []int{1, 2, 3}
-	set.AddAll(values...)
-	if set.Size() != len(values) {
-		t.Fatalf("invalid set size, expected: %d, actual: %d", len(values), set.Size())
-	}
-	for _, value := range values {
-		removed := set.Remove(value)
-		if !removed {
-			t.Fatalf("known value %v was not removed", value)
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		set := impl.new()
+		values := []int{1, 2, 3}
+		set.AddAll(values...)
+		if set.Size() != len(values) {
+			t.Fatalf("invalid set size, expected: %d, actual: %d", len(values), set.Size())
 		}
-	}
-	if !set.IsEmpty() {
-		t.Fatal("set is not empty")
-	}
-	removed := set.Remove(111)
-	if removed {
-		t.Fatal("unknown value was removed")
-	}
+		for _, value := range values {
+			removed := set.Remove(value)
+			if !removed {
+				t.Fatalf("known value %v was not removed", value)
+			}
+		}
+		if !set.IsEmpty() {
+			t.Fatal("set is not empty")
+		}
+		removed := set.Remove(111)
+		if removed {
+			t.Fatal("unknown value was removed")
+		}
+	})
 }
 
 func TestSet_Size(t *testing.T) {
-	type testCase[T comparable] struct {
-		name string
-		set  Set[T]
-		want int
-	}
-	tests := []testCase[int]{
-		{"empty", NewSet[int](), 0},
-		{"empty with capacity", NewSetCapacity[int](123), 0},
-		{"one", NewSetItems[int](1), 1},
-		{"three", NewSetItems[int](1, 2, 3), 3},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.set.Size(); got != tt.want {
-				t.Errorf("Size() = %v, want %v", got, tt.want)
-			}
-		})
-	}
+	type testCase struct {
+		name     string
+		capacity int
+		values   []int
+		want     int
+	}
+	tests := []testCase{
+		{"empty", 0, nil, 0},
+		{"empty with capacity", 123, nil, 0},
+		{"one", 0, []int{1}, 1},
+		{"three", 0, []int{1, 2, 3}, 3},
+	}
+	runSetTest(t, func(t *testing.T, impl setImplementation[int]) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				set := impl.newCapacity(tt.capacity)
+				set.AddAll(tt.values...)
+				if got := set.Size(); got != tt.want {
+					t.Errorf("Size() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
 }
 
 func TestSet_Contains(t *testing.T) {
-	values := []string{"string 1", "string 2", "string 3"}
-	set := NewSetCapacity[string](len(values))
-	if !set.AddAll(values...) {
-		t.Fatalf("values was not added to the set")
-	}
-	for _, value := range values {
-		if !set.Contains(value) {
-			t.Fatalf("the set does not contain value %s", value)
+	runSetTest(t, func(t *testing.T, impl setImplementation[string]) {
+		values := []string{"string 1", "string 2", "string 3"}
+		set := impl.newCapacity(len(values))
+		if !set.AddAll(values...) {
+			t.Fatalf("values was not added to the set")
 		}
-	}
-	unknown := "unknown string value"
-	if set.Contains(unknown) {
-		t.Fatal("the set contains an unknown value")
-	}
+		for _, value := range values {
+			if !set.Contains(value) {
+				t.Fatalf("the set does not contain value %s", value)
+			}
+		}
+		unknown := "unknown string value"
+		if set.Contains(unknown) {
+			t.Fatal("the set contains an unknown value")
+		}
+	})
 }
 
 func TestSet_TrimToSize(t *testing.T) {
 	const amount = 1_000_000
 	const rest = 20
-	set := NewSetCapacity[string](amount)
 	value := func(i int) string {
 		return fmt.Sprintf("this is a set long value %d", i)
 	}
-	for i := 1; i <= amount; i++ {
-		v := value(i)
-		if !set.Add(v) {
-			t.Fatalf("value %v was not added to the set", v)
+	runSetTest(t, func(t *testing.T, impl setImplementation[string]) {
+		set := impl.newCapacity(amount)
+		for i := 1; i <= amount; i++ {
+			v := value(i)
+			if !set.Add(v) {
+				t.Fatalf("value %v was not added to the set", v)
+			}
 		}
-	}
-	if set.Size() != amount {
-		t.Fatalf("invalid set size, expected: %d, actual: %d", amount, set.Size())
-	}
-	var m1 runtime.MemStats
-	runtime.ReadMemStats(&m1)
+		if set.Size() != amount {
+			t.Fatalf("invalid set size, expected: %d, actual: %d", amount, set.Size())
+		}
+		var m1 runtime.MemStats
+		runtime.ReadMemStats(&m1)
 
-	for i := rest + 1; i <= amount; i++ {
-		v := value(i)
-		if !set.Remove(v) {
-			t.Fatalf("value %s was not removed from the set", v)
+		for i := rest + 1; i <= amount; i++ {
+			v := value(i)
+			if !set.Remove(v) {
+				t.Fatalf("value %s was not removed from the set", v)
+			}
 		}
-	}
-	var m2 runtime.MemStats
-	runtime.ReadMemStats(&m2)
+		var m2 runtime.MemStats
+		runtime.ReadMemStats(&m2)
 
-	runtime.GC()
+		runtime.GC()
 
-	var m3 runtime.MemStats
-	runtime.ReadMemStats(&m3)
+		var m3 runtime.MemStats
+		runtime.ReadMemStats(&m3)
 
-	set.TrimToSize()
+		set.TrimToSize()
 
-	var m4 runtime.MemStats
-	runtime.ReadMemStats(&m4)
+		var m4 runtime.MemStats
+		runtime.ReadMemStats(&m4)
 
-	runtime.GC()
+		runtime.GC()
 
-	var m5 runtime.MemStats
-	runtime.ReadMemStats(&m5)
+		var m5 runtime.MemStats
+		runtime.ReadMemStats(&m5)
 
-	memToString := func(ms *runtime.MemStats) string {
-		return fmt.Sprintf("%d Kb", ms.Alloc/1024)
-	}
+		memToString := func(ms *runtime.MemStats) string {
+			return fmt.Sprintf("%d Kb", ms.Alloc/1024)
+		}
 
-	t.Logf("Memory after fill: %s; after remove: %s (GC: %s); after trim: %s (GC: %s)",
-		memToString(&m1), memToString(&m2), memToString(&m3), memToString(&m4), memToString(&m5))
+		t.Logf("Memory after fill: %s; after remove: %s (GC: %s); after trim: %s (GC: %s)",
+			memToString(&m1), memToString(&m2), memToString(&m3), memToString(&m4), memToString(&m5))
 
-	if set.Size() != rest {
-		t.Fatalf("invalid set size, expected: %d, actual: %d", rest, set.Size())
-	}
-	for i := 1; i <= rest; i++ {
-		v := value(i)
-		if !set.Contains(v) {
-			t.Fatalf("the set does not contain value %s", v)
+		if set.Size() != rest {
+			t.Fatalf("invalid set size, expected: %d, actual: %d", rest, set.Size())
 		}
-	}
+		for i := 1; i <= rest; i++ {
+			v := value(i)
+			if !set.Contains(v) {
+				t.Fatalf("the set does not contain value %s", v)
+			}
+		}
+	})
 }
@@ -0,0 +1,206 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"hash/maphash"
+	"iter"
+	"sync"
+)
+
+const defaultShardCount = 32
+
+// ShardedSet is a thread safe collection that does not contain duplicate elements.
+// The values are partitioned across a number of independently locked shards by a hash function,
+// so goroutines working with different shards do not contend for the same lock.
+//   - T - value type
+type ShardedSet[T comparable] struct {
+	shards   []setShard[T]
+	hash     func(value T) uint64
+	capacity int
+}
+
+type setShard[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+func (ss *ShardedSet[T]) shard(value T) *setShard[T] {
+	return &ss.shards[ss.hash(value)%uint64(len(ss.shards))]
+}
+
+// Add adds a specified value to the set.
+// Returns true if the value did not exist and was added to the set, otherwise returns false.
+func (ss *ShardedSet[T]) Add(value T) bool {
+	shard := ss.shard(value)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.set.Add(value)
+}
+
+// AddAll adds all the specified values to the set.
+// Returns true if this set changed as result of the call.
+func (ss *ShardedSet[T]) AddAll(values ...T) bool {
+	var changed bool
+	for _, value := range values {
+		if ss.Add(value) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Contains returns true if the set contains the value
+func (ss *ShardedSet[T]) Contains(value T) bool {
+	shard := ss.shard(value)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.set.Contains(value)
+}
+
+// Remove removes a value from the set.
+// Returns true if this set changed as result of the call.
+func (ss *ShardedSet[T]) Remove(value T) bool {
+	shard := ss.shard(value)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	return shard.set.Remove(value)
+}
+
+// Size returns the current size of the set.
+// The shards are counted one by one, so the result may not reflect concurrent modifications.
+func (ss *ShardedSet[T]) Size() int {
+	size := 0
+	for i := range ss.shards {
+		shard := &ss.shards[i]
+		shard.mu.RLock()
+		size += shard.set.Size()
+		shard.mu.RUnlock()
+	}
+	return size
+}
+
+// IsEmpty returns true if the set does not contain any values.
+func (ss *ShardedSet[T]) IsEmpty() bool {
+	for i := range ss.shards {
+		shard := &ss.shards[i]
+		shard.mu.RLock()
+		empty := shard.set.IsEmpty()
+		shard.mu.RUnlock()
+		if !empty {
+			return false
+		}
+	}
+	return true
+}
+
+// TrimToSize trims the capacity of every shard to be its current size.
+func (ss *ShardedSet[T]) TrimToSize() {
+	for i := range ss.shards {
+		shard := &ss.shards[i]
+		shard.mu.Lock()
+		shard.set.TrimToSize()
+		shard.mu.Unlock()
+	}
+}
+
+// Clear clears the set.
+func (ss *ShardedSet[T]) Clear() {
+	for i := range ss.shards {
+		shard := &ss.shards[i]
+		shard.mu.Lock()
+		shard.set.Clear()
+		shard.mu.Unlock()
+	}
+}
+
+// Capacity returns the capacity value that was set when the set was created.
+func (ss *ShardedSet[T]) Capacity() int {
+	return ss.capacity
+}
+
+// ShardCount returns the number of shards of the set.
+func (ss *ShardedSet[T]) ShardCount() int {
+	return len(ss.shards)
+}
+
+// ToSlice return a slice of the set elements.
+// All the shards are locked while the slice is being built, so the result is a consistent snapshot of the set.
+func (ss *ShardedSet[T]) ToSlice() []T {
+	for i := range ss.shards {
+		ss.shards[i].mu.RLock()
+	}
+	size := 0
+	for i := range ss.shards {
+		size += ss.shards[i].set.Size()
+	}
+	result := make([]T, 0, size)
+	for i := range ss.shards {
+		for value := range ss.shards[i].set.mp {
+			result = append(result, value)
+		}
+		ss.shards[i].mu.RUnlock()
+	}
+	return result
+}
+
+// All returns an iterator over a consistent snapshot of the set elements taken at the moment of the call,
+// so the set can be modified during the iteration.
+func (ss *ShardedSet[T]) All() iter.Seq[T] {
+	snapshot := ss.ToSlice()
+	return func(yield func(T) bool) {
+		for _, value := range snapshot {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a consistent snapshot of the set elements, the same as All.
+func (ss *ShardedSet[T]) Values() iter.Seq[T] {
+	return ss.All()
+}
+
+// NewShardedSet returns a new empty ShardedSet instance with the specified number of shards
+// that uses the hash/maphash package to distribute values across the shards.
+//   - T - value type
+//   - shardCount - the number of shards; if it is less than 1, the default number of shards is used
+func NewShardedSet[T comparable](shardCount int) *ShardedSet[T] {
+	return NewShardedSetCapacity[T](shardCount, 0)
+}
+
+// NewShardedSetCapacity returns a new empty ShardedSet instance with the specified number of shards
+// and an initial space size (capacity) evenly distributed between the shards.
+//   - T - value type
+//   - shardCount - the number of shards; if it is less than 1, the default number of shards is used
+//   - capacity - initial space size
+func NewShardedSetCapacity[T comparable](shardCount, capacity int) *ShardedSet[T] {
+	seed := maphash.MakeSeed()
+	return NewShardedSetHash[T](shardCount, capacity, func(value T) uint64 {
+		return maphash.Comparable(seed, value)
+	})
+}
+
+// NewShardedSetHash returns a new empty ShardedSet instance that uses the specified hash function
+// to distribute values across the shards.
+//   - T - value type
+//   - shardCount - the number of shards; if it is less than 1, the default number of shards is used
+//   - capacity - initial space size
+//   - hash - the function that returns a hash of a value; equal values must have equal hashes
+func NewShardedSetHash[T comparable](shardCount, capacity int, hash func(value T) uint64) *ShardedSet[T] {
+	if shardCount < 1 {
+		shardCount = defaultShardCount
+	}
+	result := &ShardedSet[T]{shards: make([]setShard[T], shardCount), hash: hash, capacity: capacity}
+	shardCapacity := 0
+	if capacity > 0 {
+		shardCapacity = (capacity + shardCount - 1) / shardCount
+	}
+	for i := range result.shards {
+		result.shards[i].set = NewSetCapacity[T](shardCapacity)
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+type benchmarkSet interface {
+	Add(value int) bool
+	Contains(value int) bool
+	Remove(value int) bool
+}
+
+func benchmarkSetParallel(b *testing.B, set benchmarkSet, writePercent int) {
+	const valueRange = 1 << 16
+	for i := 0; i < valueRange; i += 2 {
+		set.Add(i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rnd := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for pb.Next() {
+			value := rnd.IntN(valueRange)
+			if rnd.IntN(100) < writePercent {
+				if !set.Add(value) {
+					set.Remove(value)
+				}
+			} else {
+				set.Contains(value)
+			}
+		}
+	})
+}
+
+func BenchmarkShardedSet_parallel(b *testing.B) {
+	benchmarks := []struct {
+		name         string
+		writePercent int
+	}{
+		{"read", 0},
+		{"write 10%", 10},
+		{"write 50%", 50},
+	}
+	for _, bm := range benchmarks {
+		b.Run("ConcurrentSet "+bm.name, func(b *testing.B) {
+			benchmarkSetParallel(b, NewConcurrentSet[int](), bm.writePercent)
+		})
+		b.Run("ShardedSet "+bm.name, func(b *testing.B) {
+			benchmarkSetParallel(b, NewShardedSet[int](0), bm.writePercent)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestNewShardedSet(t *testing.T) {
+	type testCase struct {
+		name       string
+		set        *ShardedSet[int]
+		wantShards int
+		wantCap    int
+	}
+	tests := []testCase{
+		{"default", NewShardedSet[int](0), defaultShardCount, 0},
+		{"less then zero", NewShardedSet[int](-1), defaultShardCount, 0},
+		{"shards", NewShardedSet[int](4), 4, 0},
+		{"capacity", NewShardedSetCapacity[int](4, 10), 4, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set.ShardCount() != tt.wantShards {
+				t.Errorf("ShardCount() = %d, want %d", tt.set.ShardCount(), tt.wantShards)
+			}
+			if tt.set.Capacity() != tt.wantCap {
+				t.Errorf("Capacity() = %d, want %d", tt.set.Capacity(), tt.wantCap)
+			}
+			if !tt.set.IsEmpty() || tt.set.Size() != 0 {
+				t.Error("the set isn't empty")
+			}
+		})
+	}
+}
+
+func TestShardedSet(t *testing.T) {
+	set := NewShardedSet[string](3)
+	values := []string{"one", "two", "three", "four", "five"}
+	if !set.AddAll(values...) {
+		t.Fatal("the set was not changed")
+	}
+	if set.AddAll(values...) {
+		t.Fatal("the set was changed when trying to add duplicate values")
+	}
+	if set.Size() != len(values) {
+		t.Fatalf("invalid size, expected: %d, actual: %d", len(values), set.Size())
+	}
+	for _, value := range values {
+		if !set.Contains(value) {
+			t.Fatalf("the set does not contain value %s", value)
+		}
+	}
+	if !set.Remove("one") || set.Remove("one") || set.Contains("one") {
+		t.Fatal("the value 'one' was not removed properly")
+	}
+	set.TrimToSize()
+	actual := set.ToSlice()
+	sort.Strings(actual)
+	expected := []string{"five", "four", "three", "two"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("\nexpected: %v\n  actual: %v", expected, actual)
+	}
+	var all []string
+	for value := range set.All() {
+		all = append(all, value)
+	}
+	sort.Strings(all)
+	if !reflect.DeepEqual(all, expected) {
+		t.Fatalf("All() expected: %v, actual: %v", expected, all)
+	}
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Fatal("the set was not cleared")
+	}
+}
+
+func TestShardedSet_hash(t *testing.T) {
+	set := NewShardedSetHash[int](4, 0, func(value int) uint64 { return uint64(value) })
+	set.AddAll(0, 1, 2, 3, 4, 5, 6, 7)
+	for i := range set.shards {
+		actual := sortedSlice(set.shards[i].set)
+		expected := []int{i, i + 4}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("shard %d, expected: %v, actual: %v", i, expected, actual)
+		}
+	}
+}
+
+func TestShardedSet_concurrent(t *testing.T) {
+	const goroutines = 8
+	const amount = 1000
+	set := NewShardedSet[int](0)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				set.Add(i)
+				set.Contains(i)
+				set.Remove(amount*(g+1) + i)
+				if i%100 == 0 {
+					_ = set.ToSlice()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if set.Size() != amount {
+		t.Fatalf("invalid set size, expected: %d, actual: %d", amount, set.Size())
+	}
+}
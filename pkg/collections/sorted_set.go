@@ -0,0 +1,426 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedSet is a collection that does not contain duplicate elements and keeps them sorted
+// according to a comparison function.
+// SortedSet is based on an AVL tree, so Add, Remove, Contains and the navigation queries take O(log n) time.
+// Each tree node stores the size of its subtree, so Rank and Select also take O(log n) time.
+// SortedSet is not thread safe and not intended for concurrent usage.
+//   - T - value type
+type SortedSet[T any] struct {
+	root    *sortedSetNode[T]
+	compare func(a, b T) int
+}
+
+type sortedSetNode[T any] struct {
+	left   *sortedSetNode[T]
+	right  *sortedSetNode[T]
+	value  T
+	height int
+	size   int
+}
+
+func nodeHeight[T any](node *sortedSetNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func nodeSize[T any](node *sortedSetNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (node *sortedSetNode[T]) update() {
+	node.height = 1 + max(nodeHeight(node.left), nodeHeight(node.right))
+	node.size = 1 + nodeSize(node.left) + nodeSize(node.right)
+}
+
+func (node *sortedSetNode[T]) rotateLeft() *sortedSetNode[T] {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	node.update()
+	right.update()
+	return right
+}
+
+func (node *sortedSetNode[T]) rotateRight() *sortedSetNode[T] {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	node.update()
+	left.update()
+	return left
+}
+
+func (node *sortedSetNode[T]) balance() *sortedSetNode[T] {
+	node.update()
+	switch diff := nodeHeight(node.left) - nodeHeight(node.right); {
+	case diff > 1:
+		if nodeHeight(node.left.left) < nodeHeight(node.left.right) {
+			node.left = node.left.rotateLeft()
+		}
+		return node.rotateRight()
+	case diff < -1:
+		if nodeHeight(node.right.right) < nodeHeight(node.right.left) {
+			node.right = node.right.rotateRight()
+		}
+		return node.rotateLeft()
+	}
+	return node
+}
+
+func (set *SortedSet[T]) insert(node *sortedSetNode[T], value T) (*sortedSetNode[T], bool) {
+	if node == nil {
+		return &sortedSetNode[T]{value: value, height: 1, size: 1}, true
+	}
+	var added bool
+	switch c := set.compare(value, node.value); {
+	case c < 0:
+		node.left, added = set.insert(node.left, value)
+	case c > 0:
+		node.right, added = set.insert(node.right, value)
+	default:
+		return node, false
+	}
+	if !added {
+		return node, false
+	}
+	return node.balance(), true
+}
+
+func removeMin[T any](node *sortedSetNode[T]) (*sortedSetNode[T], *sortedSetNode[T]) {
+	if node.left == nil {
+		return node.right, node
+	}
+	var minNode *sortedSetNode[T]
+	node.left, minNode = removeMin(node.left)
+	return node.balance(), minNode
+}
+
+func (set *SortedSet[T]) delete(node *sortedSetNode[T], value T) (*sortedSetNode[T], bool) {
+	if node == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := set.compare(value, node.value); {
+	case c < 0:
+		node.left, removed = set.delete(node.left, value)
+	case c > 0:
+		node.right, removed = set.delete(node.right, value)
+	default:
+		if node.left == nil {
+			return node.right, true
+		}
+		if node.right == nil {
+			return node.left, true
+		}
+		right, successor := removeMin(node.right)
+		successor.left, successor.right = node.left, right
+		node, removed = successor, true
+	}
+	if !removed {
+		return node, false
+	}
+	return node.balance(), true
+}
+
+// Add adds a specified value to the set.
+// Returns true if the value did not exist and was added to the set, otherwise returns false.
+func (set *SortedSet[T]) Add(value T) bool {
+	var added bool
+	set.root, added = set.insert(set.root, value)
+	return added
+}
+
+// AddAll adds all the specified values to the set.
+// Returns true if this set changed as result of the call.
+func (set *SortedSet[T]) AddAll(values ...T) bool {
+	var changed bool
+	for _, value := range values {
+		if set.Add(value) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Contains returns true if the set contains the value
+func (set *SortedSet[T]) Contains(value T) bool {
+	for node := set.root; node != nil; {
+		switch c := set.compare(value, node.value); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes a value from the set.
+// Returns true if this set changed as result of the call.
+func (set *SortedSet[T]) Remove(value T) bool {
+	var removed bool
+	set.root, removed = set.delete(set.root, value)
+	return removed
+}
+
+// Size returns the current size of the set.
+func (set *SortedSet[T]) Size() int {
+	return nodeSize(set.root)
+}
+
+// IsEmpty returns true if the set does not contain any values.
+func (set *SortedSet[T]) IsEmpty() bool {
+	return set.root == nil
+}
+
+// Clear clears the set.
+func (set *SortedSet[T]) Clear() {
+	set.root = nil
+}
+
+func nodeValue[T any](node *sortedSetNode[T]) (T, bool) {
+	if node == nil {
+		var res T
+		return res, false
+	}
+	return node.value, true
+}
+
+// Min returns the smallest element of the set and true if it exists.
+// If the set is empty, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Min() (T, bool) {
+	node := set.root
+	for node != nil && node.left != nil {
+		node = node.left
+	}
+	return nodeValue(node)
+}
+
+// Max returns the largest element of the set and true if it exists.
+// If the set is empty, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Max() (T, bool) {
+	node := set.root
+	for node != nil && node.right != nil {
+		node = node.right
+	}
+	return nodeValue(node)
+}
+
+// lowerBound returns the node with the greatest value that is less than (or equal to, if inclusive is true)
+// the specified value.
+func (set *SortedSet[T]) lowerBound(value T, inclusive bool) *sortedSetNode[T] {
+	var result *sortedSetNode[T]
+	for node := set.root; node != nil; {
+		c := set.compare(node.value, value)
+		if c < 0 || (inclusive && c == 0) {
+			result = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return result
+}
+
+// upperBound returns the node with the least value that is greater than (or equal to, if inclusive is true)
+// the specified value.
+func (set *SortedSet[T]) upperBound(value T, inclusive bool) *sortedSetNode[T] {
+	var result *sortedSetNode[T]
+	for node := set.root; node != nil; {
+		c := set.compare(node.value, value)
+		if c > 0 || (inclusive && c == 0) {
+			result = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return result
+}
+
+// Floor returns the greatest element of the set less than or equal to the specified value and true if it exists.
+// If there is no such element, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Floor(value T) (T, bool) {
+	return nodeValue(set.lowerBound(value, true))
+}
+
+// Ceiling returns the least element of the set greater than or equal to the specified value and true if it exists.
+// If there is no such element, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Ceiling(value T) (T, bool) {
+	return nodeValue(set.upperBound(value, true))
+}
+
+// Lower returns the greatest element of the set strictly less than the specified value and true if it exists.
+// If there is no such element, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Lower(value T) (T, bool) {
+	return nodeValue(set.lowerBound(value, false))
+}
+
+// Higher returns the least element of the set strictly greater than the specified value and true if it exists.
+// If there is no such element, this method returns a default value of type T and false.
+func (set *SortedSet[T]) Higher(value T) (T, bool) {
+	return nodeValue(set.upperBound(value, false))
+}
+
+// Rank returns the number of elements of the set that are strictly less than the specified value.
+// If the set contains the value, the result is the index of the value in the sorted sequence.
+func (set *SortedSet[T]) Rank(value T) int {
+	rank := 0
+	for node := set.root; node != nil; {
+		switch c := set.compare(value, node.value); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			rank += nodeSize(node.left) + 1
+			node = node.right
+		default:
+			return rank + nodeSize(node.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the element with the specified index in the sorted sequence (the k-th smallest element,
+// starting from 0) and true if it exists.
+// If the index is out of range, this method returns a default value of type T and false.
+//   - k - the index of the element
+func (set *SortedSet[T]) Select(k int) (T, bool) {
+	node := set.root
+	for node != nil {
+		leftSize := nodeSize(node.left)
+		switch {
+		case k < leftSize:
+			node = node.left
+		case k > leftSize:
+			k -= leftSize + 1
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+	return nodeValue(node)
+}
+
+// ascend calls yield for the values of the subtree in ascending order, starting from the values
+// that are not less than from (if from is not nil) and stopping before the values that are not less than to
+// (if to is not nil). Returns false if yield returned false.
+func (set *SortedSet[T]) ascend(node *sortedSetNode[T], from, to *T, yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+	afterFrom := from == nil || set.compare(node.value, *from) >= 0
+	beforeTo := to == nil || set.compare(node.value, *to) < 0
+	if afterFrom && !set.ascend(node.left, from, to, yield) {
+		return false
+	}
+	if afterFrom && beforeTo && !yield(node.value) {
+		return false
+	}
+	if beforeTo {
+		return set.ascend(node.right, from, to, yield)
+	}
+	return true
+}
+
+func (set *SortedSet[T]) descend(node *sortedSetNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+	return set.descend(node.right, yield) && yield(node.value) && set.descend(node.left, yield)
+}
+
+// All returns an iterator over the set elements in ascending order.
+func (set *SortedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		set.ascend(set.root, nil, nil, yield)
+	}
+}
+
+// Values returns an iterator over the set elements in ascending order, the same as All.
+func (set *SortedSet[T]) Values() iter.Seq[T] {
+	return set.All()
+}
+
+// Backward returns an iterator over the set elements in descending order.
+func (set *SortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		set.descend(set.root, yield)
+	}
+}
+
+// RangeFrom returns an iterator over the set elements greater than or equal to the specified value
+// in ascending order.
+//   - from - the lower bound (inclusive)
+func (set *SortedSet[T]) RangeFrom(from T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		set.ascend(set.root, &from, nil, yield)
+	}
+}
+
+// RangeTo returns an iterator over the set elements strictly less than the specified value
+// in ascending order.
+//   - to - the upper bound (exclusive)
+func (set *SortedSet[T]) RangeTo(to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		set.ascend(set.root, nil, &to, yield)
+	}
+}
+
+// Range returns an iterator over the set elements that are greater than or equal to from
+// and strictly less than to in ascending order.
+//   - from - the lower bound (inclusive)
+//   - to - the upper bound (exclusive)
+func (set *SortedSet[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		set.ascend(set.root, &from, &to, yield)
+	}
+}
+
+// ToSlice return a slice of the set elements in ascending order.
+func (set *SortedSet[T]) ToSlice() []T {
+	result := make([]T, 0, set.Size())
+	for value := range set.All() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// NewSortedSet returns a new empty SortedSet instance that orders its elements by the specified comparison function.
+//   - T - value type
+//   - compare - the function that returns a negative number when a < b, a positive number when a > b and zero
+//     when a and b are equal
+func NewSortedSet[T any](compare func(a, b T) int) *SortedSet[T] {
+	return &SortedSet[T]{compare: compare}
+}
+
+// NewSortedSetOrdered returns a new empty SortedSet instance that orders its elements in ascending order.
+//   - T - value type
+func NewSortedSetOrdered[T cmp.Ordered]() *SortedSet[T] {
+	return NewSortedSet[T](cmp.Compare[T])
+}
+
+// NewSortedSetItems returns a new instance of SortedSet containing specified values.
+//   - compare - the comparison function
+//   - values ...T - values that the set will contain
+func NewSortedSetItems[T any](compare func(a, b T) int, values ...T) *SortedSet[T] {
+	result := NewSortedSet[T](compare)
+	result.AddAll(values...)
+	return result
+}
@@ -0,0 +1,202 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collections
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkSortedSetNode checks the AVL invariants and the subtree sizes, returns the height of the subtree.
+func checkSortedSetNode[T any](t *testing.T, set *SortedSet[T], node *sortedSetNode[T]) int {
+	t.Helper()
+	if node == nil {
+		return 0
+	}
+	lh := checkSortedSetNode(t, set, node.left)
+	rh := checkSortedSetNode(t, set, node.right)
+	if lh-rh > 1 || rh-lh > 1 {
+		t.Fatalf("the tree is not balanced at %v: %d, %d", node.value, lh, rh)
+	}
+	if node.height != 1+max(lh, rh) {
+		t.Fatalf("invalid height of %v: %d, want: %d", node.value, node.height, 1+max(lh, rh))
+	}
+	if node.size != 1+nodeSize(node.left)+nodeSize(node.right) {
+		t.Fatalf("invalid size of %v: %d", node.value, node.size)
+	}
+	if node.left != nil && set.compare(node.left.value, node.value) >= 0 {
+		t.Fatalf("invalid order: %v, %v", node.left.value, node.value)
+	}
+	if node.right != nil && set.compare(node.right.value, node.value) <= 0 {
+		t.Fatalf("invalid order: %v, %v", node.value, node.right.value)
+	}
+	return node.height
+}
+
+func TestNewSortedSet(t *testing.T) {
+	set := NewSortedSetOrdered[int]()
+	if set.Size() != 0 || !set.IsEmpty() {
+		t.Fatal("the set isn't empty")
+	}
+	if _, ok := set.Min(); ok {
+		t.Fatal("the min element exists")
+	}
+	if _, ok := set.Max(); ok {
+		t.Fatal("the max element exists")
+	}
+	if _, ok := set.Select(0); ok {
+		t.Fatal("the element with index 0 exists")
+	}
+	set = NewSortedSetItems(func(a, b int) int { return cmp.Compare(b, a) }, 1, 3, 2)
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, []int{3, 2, 1}) {
+		t.Fatalf("ToSlice() got: %v, want: %v", actual, []int{3, 2, 1})
+	}
+}
+
+func TestSortedSet_AddRemove(t *testing.T) {
+	const amount = 1000
+	set := NewSortedSetOrdered[int]()
+	values := rand.New(rand.NewSource(1)).Perm(amount)
+	for _, v := range values {
+		if !set.Add(v) {
+			t.Fatalf("value %d was not added to the set", v)
+		}
+	}
+	if set.Add(values[0]) || set.AddAll(values[1], values[2]) {
+		t.Fatal("dublicate value was added to the set")
+	}
+	checkSortedSetNode(t, set, set.root)
+	if set.Size() != amount {
+		t.Fatalf("invalid set size, expected: %d, actual: %d", amount, set.Size())
+	}
+	for _, v := range values[:amount/2] {
+		if !set.Remove(v) {
+			t.Fatalf("value %d was not removed", v)
+		}
+		if set.Contains(v) {
+			t.Fatalf("the set contains a removed value %d", v)
+		}
+	}
+	if set.Remove(values[0]) {
+		t.Fatal("unknown value was removed")
+	}
+	checkSortedSetNode(t, set, set.root)
+	want := slices.Clone(values[amount/2:])
+	slices.Sort(want)
+	if actual := set.ToSlice(); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("ToSlice() got: %v, want: %v", actual, want)
+	}
+	slices.Reverse(want)
+	if actual := slices.Collect(set.Backward()); !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Backward() got: %v, want: %v", actual, want)
+	}
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Fatal("the set was not cleared")
+	}
+}
+
+func TestSortedSet_navigation(t *testing.T) {
+	set := NewSortedSetItems(cmp.Compare[int], 10, 20, 30, 40)
+	type testCase struct {
+		name  string
+		query func(int) (int, bool)
+		value int
+		want  int
+		ok    bool
+	}
+	tests := []testCase{
+		{"floor equal", set.Floor, 20, 20, true},
+		{"floor between", set.Floor, 25, 20, true},
+		{"floor below", set.Floor, 5, 0, false},
+		{"ceiling equal", set.Ceiling, 20, 20, true},
+		{"ceiling between", set.Ceiling, 25, 30, true},
+		{"ceiling above", set.Ceiling, 45, 0, false},
+		{"lower equal", set.Lower, 20, 10, true},
+		{"lower first", set.Lower, 10, 0, false},
+		{"higher equal", set.Higher, 20, 30, true},
+		{"higher last", set.Higher, 40, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.query(tt.value)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("got: %d, %t, want: %d, %t", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+	if v, _ := set.Min(); v != 10 {
+		t.Fatalf("Min() got: %d, want: %d", v, 10)
+	}
+	if v, _ := set.Max(); v != 40 {
+		t.Fatalf("Max() got: %d, want: %d", v, 40)
+	}
+}
+
+func TestSortedSet_Range(t *testing.T) {
+	set := NewSortedSetOrdered[int]()
+	for i := 0; i < 10; i++ {
+		set.Add(i * 10)
+	}
+	type testCase struct {
+		name string
+		seq  func(yield func(int) bool)
+		want []int
+	}
+	tests := []testCase{
+		{"from", set.RangeFrom(65), []int{70, 80, 90}},
+		{"from equal", set.RangeFrom(80), []int{80, 90}},
+		{"to", set.RangeTo(25), []int{0, 10, 20}},
+		{"to equal", set.RangeTo(20), []int{0, 10}},
+		{"range", set.Range(30, 60), []int{30, 40, 50}},
+		{"empty range", set.Range(31, 39), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(tt.seq); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+	var actual []int
+	for v := range set.RangeFrom(30) {
+		if v > 50 {
+			break
+		}
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(actual, []int{30, 40, 50}) {
+		t.Fatalf("RangeFrom() got: %v, want: %v", actual, []int{30, 40, 50})
+	}
+}
+
+func TestSortedSet_RankSelect(t *testing.T) {
+	set := NewSortedSetOrdered[string]()
+	values := []string{"a", "c", "e", "g", "i", "k", "m"}
+	set.AddAll("m", "a", "k", "c", "i", "e", "g")
+	for i, v := range values {
+		if rank := set.Rank(v); rank != i {
+			t.Fatalf("Rank(%s) got: %d, want: %d", v, rank, i)
+		}
+		if actual, ok := set.Select(i); !ok || actual != v {
+			t.Fatalf("Select(%d) got: %s, %t, want: %s, true", i, actual, ok, v)
+		}
+	}
+	if rank := set.Rank("d"); rank != 2 {
+		t.Fatalf("Rank(d) got: %d, want: %d", rank, 2)
+	}
+	if rank := set.Rank("z"); rank != len(values) {
+		t.Fatalf("Rank(z) got: %d, want: %d", rank, len(values))
+	}
+	if _, ok := set.Select(len(values)); ok {
+		t.Fatal("Select() returned an element with index out of range")
+	}
+	if _, ok := set.Select(-1); ok {
+		t.Fatal("Select() returned an element with negative index")
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streams
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ParallelOptions configures parallel processing of a stream.
+type ParallelOptions struct {
+	// Parallelism is the number of worker goroutines; if it is less than 1, runtime.GOMAXPROCS(0) is used
+	Parallelism int
+	// Ordered defines whether the results keep the order of the source elements
+	Ordered bool
+}
+
+func (opts ParallelOptions) workers() int {
+	if opts.Parallelism < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return opts.Parallelism
+}
+
+type parallelJob[T any] struct {
+	index int
+	value T
+}
+
+type parallelResult[R any] struct {
+	index int
+	value R
+	keep  bool
+}
+
+// ParallelMapFilter applies the function to the elements of the stream on a bounded pool of worker goroutines
+// and returns a stream of the results for which the function returned true.
+// The source stream is consumed by a single goroutine, so it does not need to be thread safe,
+// but the function is called concurrently.
+// Returns the context error if the context is done before all the elements have been processed.
+//   - ctx - the context that cancels processing
+//   - opts - parallelism and ordering options
+//   - fn - the function that is applied to each element; it returns the result and whether to keep it
+func ParallelMapFilter[T, R any](ctx context.Context, s Stream[T], opts ParallelOptions,
+	fn func(value T) (R, bool)) (Stream[R], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	workers := opts.workers()
+	jobs := make(chan parallelJob[T], workers)
+	results := make(chan parallelResult[R], workers)
+	go func() {
+		defer close(jobs)
+		index := 0
+		for value := range s.seq {
+			select {
+			case jobs <- parallelJob[T]{index: index, value: value}:
+				index++
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				value, keep := fn(job.value)
+				results <- parallelResult[R]{index: job.index, value: value, keep: keep}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	collected := collectParallelResults(results, opts.Ordered)
+	if err := ctx.Err(); err != nil {
+		return Of[R](), err
+	}
+	return FromSlice(collected), nil
+}
+
+func collectParallelResults[R any](results <-chan parallelResult[R], ordered bool) []R {
+	if !ordered {
+		collected := make([]R, 0)
+		for res := range results {
+			if res.keep {
+				collected = append(collected, res.value)
+			}
+		}
+		return collected
+	}
+	all := make([]parallelResult[R], 0)
+	for res := range results {
+		for len(all) <= res.index {
+			all = append(all, parallelResult[R]{})
+		}
+		all[res.index] = res
+	}
+	collected := make([]R, 0, len(all))
+	for _, res := range all {
+		if res.keep {
+			collected = append(collected, res.value)
+		}
+	}
+	return collected
+}
+
+// ParallelMap applies the mapper function to the elements of the stream on a bounded pool of worker goroutines
+// and returns a stream of the results.
+// Returns the context error if the context is done before all the elements have been processed.
+//   - ctx - the context that cancels processing
+//   - opts - parallelism and ordering options
+//   - mapper - the function that is applied to each element
+func ParallelMap[T, R any](ctx context.Context, s Stream[T], opts ParallelOptions,
+	mapper func(value T) R) (Stream[R], error) {
+	return ParallelMapFilter(ctx, s, opts, func(value T) (R, bool) { return mapper(value), true })
+}
+
+// ParallelFilter evaluates the condition for the elements of the stream on a bounded pool of worker goroutines
+// and returns a stream of the elements that satisfy the condition.
+// Returns the context error if the context is done before all the elements have been processed.
+//   - ctx - the context that cancels processing
+//   - opts - parallelism and ordering options
+//   - match - the function that is applied to each element to determine if it should be included
+func ParallelFilter[T any](ctx context.Context, s Stream[T], opts ParallelOptions,
+	match func(value T) bool) (Stream[T], error) {
+	return ParallelMapFilter(ctx, s, opts, func(value T) (T, bool) { return value, match(value) })
+}
+
+// ParallelReduce performs a reduction of the elements of the stream on a bounded pool of worker goroutines.
+// Each worker reduces its share of the elements starting from the identity value using the accumulator function,
+// then the partial results are combined by the combiner function in an unspecified order.
+// Returns the context error if the context is done before all the elements have been processed.
+//   - ctx - the context that cancels processing
+//   - opts - parallelism options; the Ordered option is ignored
+//   - identity - the identity value of the reduction, combiner(identity, x) must be equal to x
+//   - accumulator - the function that combines an accumulated value with the next element
+//   - combiner - the function that combines two accumulated values
+func ParallelReduce[T, R any](ctx context.Context, s Stream[T], opts ParallelOptions, identity R,
+	accumulator func(acc R, value T) R, combiner func(acc1, acc2 R) R) (R, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	workers := opts.workers()
+	jobs := make(chan T, workers)
+	go func() {
+		defer close(jobs)
+		for value := range s.seq {
+			select {
+			case jobs <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	partials := make(chan R, workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			acc := identity
+			for value := range jobs {
+				if ctx.Err() == nil {
+					acc = accumulator(acc, value)
+				}
+			}
+			partials <- acc
+		}()
+	}
+	result := identity
+	for i := 0; i < workers; i++ {
+		result = combiner(result, <-partials)
+	}
+	if err := ctx.Err(); err != nil {
+		return identity, err
+	}
+	return result, nil
+}
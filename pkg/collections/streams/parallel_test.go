@@ -0,0 +1,127 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streams
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PavloVM7/go-collections/pkg/collections"
+	"github.com/PavloVM7/go-collections/pkg/collections/lists"
+)
+
+func rangeStream(n int) Stream[int] {
+	return FromSeq(func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+}
+
+func TestParallelMap_ordered(t *testing.T) {
+	const amount = 1000
+	result, err := ParallelMap(context.Background(), rangeStream(amount), ParallelOptions{Parallelism: 4, Ordered: true},
+		func(value int) int { return value * 2 })
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	list := result.ToList()
+	if list.Size() != amount {
+		t.Fatalf("unexpected size: %d, want: %d", list.Size(), amount)
+	}
+	for i, value := range list.All() {
+		if value != i*2 {
+			t.Fatalf("unexpected value at %d: %d, want: %d", i, value, i*2)
+		}
+	}
+}
+
+func TestParallelMap_unordered(t *testing.T) {
+	source := collections.NewSetItems(1, 2, 3, 4, 5)
+	result, err := ParallelMap(context.Background(), FromSet(source), ParallelOptions{},
+		func(value int) int { return -value })
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	actual := ToSet(result)
+	if !actual.Equal(collections.NewSetItems(-1, -2, -3, -4, -5)) {
+		t.Fatalf("ParallelMap() got: %v", actual.ToSlice())
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	source := lists.NewLinkedListItems(1, 2, 3, 4, 5, 6, 7, 8)
+	for _, ordered := range []bool{true, false} {
+		result, err := ParallelFilter(context.Background(), FromList(source),
+			ParallelOptions{Parallelism: 3, Ordered: ordered}, func(value int) bool { return value%2 == 0 })
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		actual := result.ToSlice()
+		if !ordered {
+			sort.Ints(actual)
+		}
+		if !reflect.DeepEqual(actual, []int{2, 4, 6, 8}) {
+			t.Fatalf("ParallelFilter() ordered: %t, got: %v", ordered, actual)
+		}
+	}
+}
+
+func TestParallelMapFilter_empty(t *testing.T) {
+	result, err := ParallelMapFilter(context.Background(), Of[int](), ParallelOptions{Ordered: true},
+		func(value int) (int, bool) { return value, true })
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if result.Count() != 0 {
+		t.Fatal("the result must be empty")
+	}
+}
+
+func TestParallelMap_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	infinite := FromSeq(func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	})
+	_, err := ParallelMap(ctx, infinite, ParallelOptions{Parallelism: 2}, func(value int) int {
+		if calls.Add(1) == 100 {
+			cancel()
+		}
+		return value
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error: '%v', got: '%v'", context.Canceled, err)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	const amount = 1000
+	sum, err := ParallelReduce(context.Background(), rangeStream(amount), ParallelOptions{Parallelism: 4}, 0,
+		func(acc, value int) int { return acc + value }, func(acc1, acc2 int) int { return acc1 + acc2 })
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if want := amount * (amount - 1) / 2; sum != want {
+		t.Fatalf("ParallelReduce() got: %d, want: %d", sum, want)
+	}
+}
+
+func TestParallelReduce_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ParallelReduce(ctx, rangeStream(10), ParallelOptions{}, 0,
+		func(acc, value int) int { return acc + value }, func(acc1, acc2 int) int { return acc1 + acc2 })
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error: '%v', got: '%v'", context.Canceled, err)
+	}
+}
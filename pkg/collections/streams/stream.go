@@ -0,0 +1,280 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package streams contains lazy streams over collections and their manipulation
+package streams
+
+import (
+	"iter"
+
+	"github.com/PavloVM7/go-collections/pkg/collections"
+	"github.com/PavloVM7/go-collections/pkg/collections/lists"
+)
+
+// Stream is a lazy sequence of elements supporting fused intermediate and terminal operations.
+// Intermediate operations (Filter, Map, Limit, etc.) do not process any elements, they only describe
+// the pipeline. The elements are pulled one by one through the whole pipeline by a terminal operation
+// (ToSlice, ToList, Collect, etc.), so no intermediate collections are allocated.
+//   - T - element type
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Entry is a key-value pair of a map.
+//   - K - key type
+//   - V - value type
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Of returns a stream of the specified values.
+func Of[T any](values ...T) Stream[T] {
+	return FromSlice(values)
+}
+
+// FromSeq returns a stream of the elements of the iterator.
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromSlice returns a stream of the elements of the slice.
+func FromSlice[T any](slice []T) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, value := range slice {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// FromList returns a stream of the elements of the list (from the first to the last element).
+func FromList[T any](list *lists.LinkedList[T]) Stream[T] {
+	return FromSeq(list.Values())
+}
+
+// FromSet returns a stream of the elements of the set in an unspecified order.
+func FromSet[T comparable](set collections.Set[T]) Stream[T] {
+	return FromSeq(set.All())
+}
+
+// FromMap returns a stream of the key-value pairs of the map in an unspecified order.
+//   - K - key type
+//   - V - value type
+func FromMap[K comparable, V any](mp map[K]V) Stream[Entry[K, V]] {
+	return FromSeq(func(yield func(Entry[K, V]) bool) {
+		for k, v := range mp {
+			if !yield(Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
+
+// FromMapKeys returns a stream of the keys of the map in an unspecified order.
+func FromMapKeys[K comparable, V any](mp map[K]V) Stream[K] {
+	return FromSeq(func(yield func(K) bool) {
+		for k := range mp {
+			if !yield(k) {
+				return
+			}
+		}
+	})
+}
+
+// FromMapValues returns a stream of the values of the map in an unspecified order.
+func FromMapValues[K comparable, V any](mp map[K]V) Stream[V] {
+	return FromSeq(func(yield func(V) bool) {
+		for _, v := range mp {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// All returns an iterator over the elements of the stream.
+func (s Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter returns a stream consisting of the elements of this stream that satisfy the condition.
+//   - match - the function that is applied to each element to determine if it should be included
+func (s Stream[T]) Filter(match func(value T) bool) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if match(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Limit returns a stream consisting of no more than the specified number of elements of this stream.
+//   - maxSize - the maximum number of elements
+func (s Stream[T]) Limit(maxSize int) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if maxSize <= 0 {
+			return
+		}
+		count := 0
+		for value := range s.seq {
+			if !yield(value) {
+				return
+			}
+			count++
+			if count >= maxSize {
+				return
+			}
+		}
+	})
+}
+
+// Skip returns a stream consisting of the elements of this stream after discarding the specified number
+// of the first elements.
+//   - n - the number of elements to skip
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		skipped := 0
+		for value := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Peek returns a stream consisting of the elements of this stream, additionally performing the action
+// on each element as it is consumed from the resulting stream.
+//   - action - the function that is applied to each element
+func (s Stream[T]) Peek(action func(value T)) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			action(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted returns a stream consisting of the elements of this stream sorted according to the order specified
+// by the less function. The sort is stable, so equal elements keep their encounter order.
+// This operation has to consume all the elements of this stream before producing
+// the first element, the elements are buffered in a LinkedList and sorted by lists.SortListStable.
+//   - less - the function used to compare elements
+func (s Stream[T]) Sorted(less func(item1, item2 T) bool) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		list := s.ToList()
+		lists.SortListStable(list, less)
+		for value := range list.Values() {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// ForEach performs the action for each element of this stream.
+//   - action - the function that is applied to each element
+func (s Stream[T]) ForEach(action func(value T)) {
+	for value := range s.seq {
+		action(value)
+	}
+}
+
+// Count returns the number of elements in this stream.
+func (s Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// First returns the first element of this stream and true if it exists.
+// If the stream is empty, a default value of type T and false is returned.
+func (s Stream[T]) First() (T, bool) {
+	for value := range s.seq {
+		return value, true
+	}
+	var res T
+	return res, false
+}
+
+// ToSlice returns a slice containing the elements of this stream.
+func (s Stream[T]) ToSlice() []T {
+	result := make([]T, 0)
+	for value := range s.seq {
+		result = append(result, value)
+	}
+	return result
+}
+
+// ToList returns a LinkedList containing the elements of this stream.
+func (s Stream[T]) ToList() *lists.LinkedList[T] {
+	result := lists.NewLinkedList[T]()
+	for value := range s.seq {
+		result.AddLast(value)
+	}
+	return result
+}
+
+// Map returns a stream consisting of the results of applying the mapper function to the elements of the stream.
+//   - mapper - the function that is applied to each element
+func Map[T, R any](s Stream[T], mapper func(value T) R) Stream[R] {
+	return FromSeq(func(yield func(R) bool) {
+		for value := range s.seq {
+			if !yield(mapper(value)) {
+				return
+			}
+		}
+	})
+}
+
+// Distinct returns a stream consisting of the distinct elements of the stream in the order of their first
+// occurrence. The elements that have already been seen are tracked by a Set.
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		seen := collections.NewSet[T]()
+		for value := range s.seq {
+			if seen.Add(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// ToSet returns a Set containing the distinct elements of the stream.
+func ToSet[T comparable](s Stream[T]) collections.Set[T] {
+	result := collections.NewSet[T]()
+	for value := range s.seq {
+		result.Add(value)
+	}
+	return result
+}
+
+// Reduce performs a reduction of the elements of the stream using the initial value and the accumulator function,
+// and returns the reduced value.
+//   - initial - the initial value of the reduction
+//   - accumulator - the function that combines the accumulated value with the next element
+func Reduce[T, R any](s Stream[T], initial R, accumulator func(acc R, value T) R) R {
+	result := initial
+	for value := range s.seq {
+		result = accumulator(result, value)
+	}
+	return result
+}
+
+// Collect passes the iterator over the elements of the stream to the collector function and returns its result,
+// so any function consuming an iter.Seq can be used as a collector (e.g. slices.Collect).
+//   - collector - the function that consumes the elements
+func Collect[T, R any](s Stream[T], collector func(seq iter.Seq[T]) R) R {
+	return collector(s.seq)
+}
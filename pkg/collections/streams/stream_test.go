@@ -0,0 +1,155 @@
+// Copyright Ⓒ 2023 Pavlo Moisieienko. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streams
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/PavloVM7/go-collections/pkg/collections"
+	"github.com/PavloVM7/go-collections/pkg/collections/lists"
+)
+
+func TestFromSources(t *testing.T) {
+	want := []int{1, 2, 3}
+	tests := []struct {
+		name   string
+		stream Stream[int]
+	}{
+		{"Of", Of(1, 2, 3)},
+		{"FromSlice", FromSlice([]int{1, 2, 3})},
+		{"FromList", FromList(lists.NewLinkedListItems(1, 2, 3))},
+		{"FromSet", FromSet(collections.NewSetItems(1, 2, 3))},
+		{"FromSeq", FromSeq(slices.Values([]int{1, 2, 3}))},
+		{"FromMapKeys", FromMapKeys(map[int]string{1: "1", 2: "2", 3: "3"})},
+		{"FromMapValues", FromMapValues(map[string]int{"1": 1, "2": 2, "3": 3})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.stream.ToSlice()
+			sort.Ints(actual)
+			if !reflect.DeepEqual(actual, want) {
+				t.Errorf("got: %v, want: %v", actual, want)
+			}
+		})
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	mp := map[string]int{"one": 1, "two": 2}
+	actual := ToSet(FromMap(mp))
+	if actual.Size() != 2 || !actual.Contains(Entry[string, int]{"one", 1}) ||
+		!actual.Contains(Entry[string, int]{"two", 2}) {
+		t.Fatalf("FromMap() got: %v", actual.ToSlice())
+	}
+}
+
+func TestStream_pipeline(t *testing.T) {
+	var peeked []int
+	stream := Of(1, 2, 3, 4, 5, 6, 7, 8, 9, 10).
+		Skip(1).
+		Filter(func(value int) bool { return value%2 == 0 }).
+		Peek(func(value int) { peeked = append(peeked, value) }).
+		Limit(3)
+	actual := Map(stream, func(value int) string { return fmt.Sprint("v", value) }).ToSlice()
+	want := []string{"v2", "v4", "v6"}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("got: %v, want: %v", actual, want)
+	}
+	if !reflect.DeepEqual(peeked, []int{2, 4, 6}) {
+		t.Fatalf("the stream is not lazy, peeked: %v", peeked)
+	}
+}
+
+func TestStream_Limit(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  []int
+	}{
+		{-1, []int{}},
+		{0, []int{}},
+		{2, []int{1, 2}},
+		{5, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.limit), func(t *testing.T) {
+			if actual := Of(1, 2, 3).Limit(tt.limit).ToSlice(); !reflect.DeepEqual(actual, tt.want) {
+				t.Errorf("Limit() got: %v, want: %v", actual, tt.want)
+			}
+		})
+	}
+}
+
+func TestStream_Skip(t *testing.T) {
+	if actual := Of(1, 2, 3).Skip(5).ToSlice(); len(actual) != 0 {
+		t.Fatalf("Skip() got: %v, want empty", actual)
+	}
+	if actual := Of(1, 2, 3).Skip(-1).ToSlice(); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("Skip() got: %v, want: %v", actual, []int{1, 2, 3})
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	actual := Distinct(Of(3, 1, 3, 2, 1, 4)).ToSlice()
+	want := []int{3, 1, 2, 4}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Distinct() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestStream_Sorted(t *testing.T) {
+	actual := Of(5, 3, 4, 1, 2).Sorted(func(item1, item2 int) bool { return item1 < item2 }).Limit(3).ToList()
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(actual.ToArray(), want) {
+		t.Fatalf("Sorted() got: %v, want: %v", actual.ToArray(), want)
+	}
+}
+
+func TestStream_Sorted_stable(t *testing.T) {
+	words := Of("bb", "a", "cc", "b", "aa", "c")
+	actual := words.Sorted(func(item1, item2 string) bool { return len(item1) < len(item2) }).ToSlice()
+	want := []string{"a", "b", "c", "bb", "cc", "aa"}
+	if !reflect.DeepEqual(actual, want) {
+		t.Fatalf("Sorted() got: %v, want: %v", actual, want)
+	}
+}
+
+func TestStream_terminal(t *testing.T) {
+	stream := Of("a", "b", "c")
+	if count := stream.Count(); count != 3 {
+		t.Fatalf("Count() got: %d, want: %d", count, 3)
+	}
+	if first, ok := stream.First(); !ok || first != "a" {
+		t.Fatalf("First() got: '%s', %t, want: 'a', true", first, ok)
+	}
+	if _, ok := Of[string]().First(); ok {
+		t.Fatal("First() of an empty stream must not exist")
+	}
+	joined := ""
+	stream.ForEach(func(value string) { joined += value })
+	if joined != "abc" {
+		t.Fatalf("ForEach() got: '%s', want: '%s'", joined, "abc")
+	}
+	if actual := Reduce(stream, 0, func(acc int, value string) int { return acc + len(value) }); actual != 3 {
+		t.Fatalf("Reduce() got: %d, want: %d", actual, 3)
+	}
+	if actual := Collect(stream, slices.Collect[string]); !reflect.DeepEqual(actual, []string{"a", "b", "c"}) {
+		t.Fatalf("Collect() got: %v, want: %v", actual, []string{"a", "b", "c"})
+	}
+	set := ToSet(Of(1, 1, 2))
+	if set.Size() != 2 {
+		t.Fatalf("ToSet() got: %v", set.ToSlice())
+	}
+	var values []string
+	for value := range stream.All() {
+		values = append(values, value)
+	}
+	if !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Fatalf("All() got: %v", values)
+	}
+}